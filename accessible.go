@@ -0,0 +1,108 @@
+package hangman
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Renderer of the accessible mode: plain sentences for screen readers, without drawings nor cursor movement
+type accessibleRenderer struct{}
+
+// This is the hangman accessible game, every change is told in a sentence
+func (game HangManData) AccessibleGame() error {
+	return game.PlayTerminal(accessibleRenderer{}, accessibleInput{})
+}
+
+// Input source of the accessible mode, each input is a line of the terminal
+type accessibleInput struct{}
+
+func (accessibleInput) Next() (string, error) {
+	return ReadLine(T("accessible.prompt"))
+}
+
+func (accessibleRenderer) Start(hang *HangManData) error {
+	fmt.Println(T("accessible.start", plural(len(hang.Word), "count.character")))
+	fmt.Println(T("accessible.seed", hang.Seed))
+	fmt.Println(hang.Describe())
+	return nil
+}
+
+func (accessibleRenderer) Guess(hang *HangManData, result GuessResult) error {
+	input := strings.ToUpper(result.Input)
+	switch {
+	case result.Kind == WordGuess && result.Cost == 0:
+		fmt.Println(T("accessible.wordFound", input))
+	case result.Kind == WordGuess:
+		fmt.Println(T("accessible.wordWrong", input, plural(result.Cost, "count.attempt")))
+	case result.Revealed != 0:
+		fmt.Println(T("accessible.letterFound", input, plural(result.Revealed, "count.time")))
+	case result.Cost != 0:
+		fmt.Println(T("accessible.letterWrong", input, plural(result.Cost, "count.attempt")))
+	default:
+		fmt.Println(T("accessible.letterWrongFree", input))
+	}
+	if result.Status == InProgress {
+		fmt.Println(hang.Describe())
+	}
+	return nil
+}
+
+func (accessibleRenderer) Reject(hang *HangManData, input string, err error) error {
+	if errors.Is(err, ErrAlreadyGuessed) {
+		fmt.Println(T("accessible.already", strings.ToUpper(strings.TrimSpace(input))))
+	} else {
+		fmt.Println(T("accessible.invalid"))
+	}
+	return nil
+}
+
+func (accessibleRenderer) Message(text string) {
+	fmt.Println(text)
+}
+
+func (accessibleRenderer) End(hang *HangManData) error {
+	if hang.Status() == Won {
+		fmt.Println(T("accessible.won", hang.ToFind))
+	} else {
+		fmt.Println(T("accessible.lost", hang.ToFind))
+	}
+	return nil
+}
+
+// Describe returns the state of the game in one sentence, for example
+// "Word: H blank L L blank, 7 attempts left, used letters A, E".
+func (hang *HangManData) Describe() string {
+	sentence := T("describe.word", SpellWord(hang.Word)) + T("describe.attempts", plural(hang.Attempts, "count.attempt"))
+	if len(hang.ListLetter) == 0 {
+		sentence += T("describe.noLetter")
+	} else {
+		letters := make([]string, len(hang.ListLetter))
+		for i, letter := range hang.ListLetter {
+			letters[i] = string(unicode.ToUpper(letter))
+		}
+		sentence += T("describe.letters", strings.Join(letters, ", "))
+	}
+	if len(hang.ListWord) != 0 {
+		sentence += T("describe.words", strings.ToUpper(strings.Join(hang.ListWord, ", ")))
+	}
+	return sentence
+}
+
+// Return the word with its positions separated by spaces: the letters to find are "blank",
+// the spaces "space" (in the language of the messages) and the other runes are given as they are.
+func SpellWord(word []rune) string {
+	positions := make([]string, len(word))
+	for i, char := range word {
+		switch {
+		case char == '_':
+			positions[i] = T("spell.blank")
+		case unicode.IsSpace(char):
+			positions[i] = T("spell.space")
+		default:
+			positions[i] = string(unicode.ToUpper(char))
+		}
+	}
+	return strings.Join(positions, " ")
+}
@@ -0,0 +1,58 @@
+package hangman
+
+import "testing"
+
+// Show the messages of the test in the language, the previous one is given back at the end
+func setTestLocale(t *testing.T, name string) {
+	t.Helper()
+	previous := Locale()
+	if err := SetLocale(name); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { SetLocale(previous) })
+}
+
+func TestSpellWord(t *testing.T) {
+	tests := []struct {
+		locale string
+		word   string
+		spelt  string
+	}{
+		{"en", "h_ll_", "H blank L L blank"},
+		{"en", "new y_rk", "N E W space Y blank R K"},
+		{"en", "é-2", "É - 2"},
+		{"en", "", ""},
+		{"fr", "h_ll_", "H vide L L vide"},
+		{"fr", "a b", "A espace B"},
+	}
+	for _, test := range tests {
+		setTestLocale(t, test.locale)
+		if spelt := SpellWord([]rune(test.word)); spelt != test.spelt {
+			t.Errorf("SpellWord(%q) in %s = %q, expected %q", test.word, test.locale, spelt, test.spelt)
+		}
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	hang := newTestGame("hello", testRules)
+	hang.Word = []rune("h_ll_")
+	tests := []struct {
+		locale   string
+		attempts int
+		letters  string
+		words    []string
+		sentence string
+	}{
+		{"en", 7, "", nil, "Word: H blank L L blank, 7 attempts left, no letter used"},
+		{"en", 1, "ae", nil, "Word: H blank L L blank, 1 attempt left, used letters A, E"},
+		{"en", 0, "a", []string{"help", "hullo"}, "Word: H blank L L blank, 0 attempts left, used letters A, used words HELP, HULLO"},
+		{"fr", 2, "a", nil, "Mot : H vide L L vide, 2 essais restants, lettres proposées A"},
+	}
+	for _, test := range tests {
+		setTestLocale(t, test.locale)
+		hang.Attempts, hang.ListLetter, hang.ListWord = test.attempts, []rune(test.letters), test.words
+		if sentence := hang.Describe(); sentence != test.sentence {
+			t.Errorf("Describe = %q, expected %q", sentence, test.sentence)
+		}
+	}
+}
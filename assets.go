@@ -0,0 +1,123 @@
+package hangman
+
+import (
+	"io/fs"
+	"sync"
+	"time"
+)
+
+// Assets keeps the parsed fonts and hangman drawings: each file is read once, then shared by every game (and goroutine)
+type Assets struct {
+	mu      sync.RWMutex
+	fonts   map[string]*Font       // Fonts by path
+	hangmen map[string]*HangmanArt // Hangman drawings by path
+	stamps  map[string]time.Time   // Modification time of the files when they were read, used by Watch
+}
+
+// Assets used by the display functions
+var DefaultAssets = NewAssets()
+
+// Return an empty cache, the files are read from the resources on first use
+func NewAssets() *Assets {
+	return &Assets{
+		fonts:   map[string]*Font{},
+		hangmen: map[string]*HangmanArt{},
+		stamps:  map[string]time.Time{},
+	}
+}
+
+// Return the font of the given resource, read it only the first time
+func (assets *Assets) Font(path string) (*Font, error) {
+	return cached(assets, assets.fonts, path, LoadFont)
+}
+
+// Return the hangman drawings of the given resource, read them only the first time
+func (assets *Assets) Hangman(path string) (*HangmanArt, error) {
+	return cached(assets, assets.hangmen, path, ReadHang)
+}
+
+// Return the value of the cache, or read it and keep it. Errors are not kept, a fixed file is read again.
+func cached[T any](assets *Assets, cache map[string]T, path string, read func(string) (T, error)) (T, error) {
+	assets.mu.RLock()
+	value, ok := cache[path]
+	assets.mu.RUnlock()
+	if ok {
+		return value, nil
+	}
+
+	stamp := modTime(path) // Taken before reading, a change during the reading is seen by Watch
+	value, err := read(path)
+	if err != nil {
+		return value, err
+	}
+
+	assets.mu.Lock()
+	cache[path] = value
+	assets.stamps[path] = stamp
+	assets.mu.Unlock()
+	return value, nil
+}
+
+// Forget every file, they are read again on next use
+func (assets *Assets) Reload() {
+	assets.mu.Lock()
+	assets.fonts = map[string]*Font{}
+	assets.hangmen = map[string]*HangmanArt{}
+	assets.stamps = map[string]time.Time{}
+	assets.mu.Unlock()
+}
+
+// Forget the files that changed since they were read, return their paths
+func (assets *Assets) Refresh() []string {
+	assets.mu.RLock()
+	var changed []string
+	for path, stamp := range assets.stamps {
+		if !modTime(path).Equal(stamp) {
+			changed = append(changed, path)
+		}
+	}
+	assets.mu.RUnlock()
+
+	if len(changed) != 0 {
+		assets.mu.Lock()
+		for _, path := range changed {
+			delete(assets.fonts, path)
+			delete(assets.hangmen, path)
+			delete(assets.stamps, path)
+		}
+		assets.mu.Unlock()
+	}
+	return changed
+}
+
+// Checks the files every interval and forgets the ones that changed (hot reload), until stop is called
+func (assets *Assets) Watch(interval time.Duration) (stop func()) {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				assets.Refresh()
+			case <-done:
+				return
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			ticker.Stop()
+			close(done)
+		})
+	}
+}
+
+// Return the modification time of the resource, the zero time if it has none (built-in files) or doesn't exist
+func modTime(path string) time.Time {
+	info, err := fs.Stat(resources, path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
@@ -0,0 +1,53 @@
+package hangman
+
+import (
+	"slices"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestAssets(t *testing.T) {
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	files := fstest.MapFS{
+		"HangMan_Position/one.txt": {Data: []byte("# height: 1\na\n"), ModTime: date},
+		"HangMan_Position/two.txt": {Data: []byte("# height: 1\na\n\nb\n"), ModTime: date},
+		"HangMan_Position/bad.txt": {Data: []byte("# height: x\n"), ModTime: date},
+	}
+	setTestResources(t, files)
+	assets := NewAssets()
+
+	first, err := assets.Hangman("HangMan_Position/one.txt")
+	if err != nil {
+		t.Fatalf("Hangman = %v", err)
+	}
+	if again, _ := assets.Hangman("HangMan_Position/one.txt"); again != first {
+		t.Errorf("Hangman read the file again instead of using the cache")
+	}
+	if _, err := assets.Hangman("HangMan_Position/two.txt"); err != nil {
+		t.Fatalf("Hangman = %v", err)
+	}
+	if _, err := assets.Hangman("HangMan_Position/bad.txt"); err == nil {
+		t.Fatalf("Hangman of an invalid file = nil, expected an error")
+	}
+	if changed := assets.Refresh(); len(changed) != 0 {
+		t.Errorf("Refresh without change = %v", changed)
+	}
+
+	files["HangMan_Position/one.txt"] = &fstest.MapFile{Data: []byte("# height: 1\na\n\nb\n\nc\n"), ModTime: date.Add(time.Minute)}
+	files["HangMan_Position/bad.txt"] = &fstest.MapFile{Data: []byte("# height: 1\na\n"), ModTime: date.Add(time.Minute)}
+	if changed := assets.Refresh(); !slices.Equal(changed, []string{"HangMan_Position/one.txt"}) {
+		t.Errorf("Refresh = %v, expected the changed file that was read", changed)
+	}
+	if art, err := assets.Hangman("HangMan_Position/one.txt"); err != nil || len(art.Frames) != 3 {
+		t.Errorf("Hangman after Refresh = %v, %v, expected the 3 frames of the new file", art, err)
+	}
+	if _, err := assets.Hangman("HangMan_Position/bad.txt"); err != nil { // Errors are not kept
+		t.Errorf("Hangman of a fixed file = %v", err)
+	}
+
+	assets.Reload()
+	if again, _ := assets.Hangman("HangMan_Position/two.txt"); len(again.Frames) != 2 {
+		t.Errorf("Hangman after Reload = %v", again)
+	}
+}
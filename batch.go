@@ -0,0 +1,119 @@
+package hangman
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Renderer of the batch mode: the guesses are read one per line (see lineInput), nothing is asked to the player
+// and a line of fields separated by tabs is written for each step, so that a program can read it:
+//
+//	start	WORD	ATTEMPTS	SEED
+//	guess	INPUT	hit|miss|invalid|repeat	WORD	ATTEMPTS
+//	won|lost	ANSWER	ATTEMPTS
+//
+// WORD is the word as shown ('_' for the letters to find), ANSWER is the word to find and SEED replays the game with --seed.
+// The empty lines are skipped, STOP and QUIT work as in the other modes. The other messages are written on the standard error.
+type batchRenderer struct {
+	output io.Writer
+}
+
+// Input source of the batch and json modes, each guess is a line of the input
+type lineInput struct {
+	lines *bufio.Scanner
+}
+
+// This is the hangman batch game, the guesses come from input and the results are written in output.
+// Return ErrGameLost if the game is lost and ErrUnfinished if the input ends before the end of the game.
+func (game HangManData) BatchGame(input io.Reader, output io.Writer) error {
+	return game.script(&batchRenderer{output: output}, input)
+}
+
+// This is the hangman json game, it plays as the batch game and writes the events of the game in output (see EventRenderer)
+func (game HangManData) JSONGame(input io.Reader, output io.Writer) error {
+	return game.script(NewEventRenderer(output), input)
+}
+
+// Plays the guesses of input without asking anything, the error tells how the game ended (see BatchGame)
+func (game HangManData) script(renderer Renderer, input io.Reader) error {
+	if err := game.PlayTerminal(renderer, &lineInput{lines: bufio.NewScanner(input)}); err != nil {
+		return err
+	}
+	switch game.Status() {
+	case Lost:
+		return ErrGameLost
+	case InProgress: // Input over, or left with STOP or QUIT
+		return ErrUnfinished
+	}
+	return nil
+}
+
+// Plays the game of the mode (BatchGame or JSONGame) with the guesses of the file, "" or "-" for the standard input
+func (game HangManData) scriptFrom(mode func(HangManData, io.Reader, io.Writer) error, fichier string) error {
+	if fichier == "" || fichier == "-" {
+		return mode(game, stdin, os.Stdout) // The lines already read by the prompts are kept
+	}
+	readFile, err := os.Open(fichier)
+	if err != nil {
+		return err
+	}
+	defer readFile.Close()
+	return mode(game, readFile, os.Stdout)
+}
+
+func (input *lineInput) Next() (string, error) {
+	for input.lines.Scan() {
+		if line := strings.TrimSpace(input.lines.Text()); line != "" {
+			return line, nil
+		}
+	}
+	if err := input.lines.Err(); err != nil {
+		return "", err
+	}
+	return "", io.EOF
+}
+
+// Writes a line of the fields separated by tabs
+func (batch *batchRenderer) write(fields ...any) error {
+	texts := make([]string, len(fields))
+	for i, field := range fields {
+		texts[i] = strings.ReplaceAll(fmt.Sprint(field), "\t", " ") // A tab would add a field
+	}
+	_, err := fmt.Fprintln(batch.output, strings.Join(texts, "\t"))
+	return err
+}
+
+func (batch *batchRenderer) Start(hang *HangManData) error {
+	return batch.write("start", string(hang.Word), hang.Attempts, hang.Seed)
+}
+
+func (batch *batchRenderer) Guess(hang *HangManData, result GuessResult) error {
+	outcome := "miss"
+	if result.Revealed != 0 || result.Cost == 0 && result.Status == Won {
+		outcome = "hit"
+	}
+	return batch.write("guess", result.Input, outcome, string(hang.Word), hang.Attempts)
+}
+
+func (batch *batchRenderer) Reject(hang *HangManData, input string, err error) error {
+	outcome := "invalid"
+	if errors.Is(err, ErrAlreadyGuessed) {
+		outcome = "repeat"
+	}
+	return batch.write("guess", strings.TrimSpace(input), outcome, string(hang.Word), hang.Attempts)
+}
+
+func (batch *batchRenderer) Message(text string) {
+	fmt.Fprintln(os.Stderr, text)
+}
+
+func (batch *batchRenderer) End(hang *HangManData) error {
+	if hang.Status() == Won {
+		return batch.write("won", hang.ToFind, hang.Attempts)
+	}
+	return batch.write("lost", hang.ToFind, hang.Attempts)
+}
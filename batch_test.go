@@ -0,0 +1,53 @@
+package hangman
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBatchGame(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		err      error
+		expected string
+	}{
+		{name: "won", input: "l\nz\n\n l \n-\nhello\n", expected: "start\t_____\t6\t1\n" +
+			"guess\tl\thit\t__ll_\t6\n" +
+			"guess\tz\tmiss\t__ll_\t5\n" +
+			"guess\tl\trepeat\t__ll_\t5\n" +
+			"guess\t-\tinvalid\t__ll_\t5\n" +
+			"guess\thello\thit\thello\t5\n" +
+			"won\thello\t5\n"},
+		{name: "won with the last attempt", input: "world\nword\nz\ne\nhello\n", expected: "start\t_____\t6\t1\n" +
+			"guess\tworld\tmiss\t_____\t4\n" +
+			"guess\tword\tmiss\t_____\t2\n" +
+			"guess\tz\tmiss\t_____\t1\n" +
+			"guess\te\thit\t_e___\t1\n" +
+			"guess\thello\thit\thello\t1\n" +
+			"won\thello\t1\n"},
+		{name: "lost", input: "world\nword\nz\ny\ne\n", err: ErrGameLost, expected: "start\t_____\t6\t1\n" +
+			"guess\tworld\tmiss\t_____\t4\n" +
+			"guess\tword\tmiss\t_____\t2\n" +
+			"guess\tz\tmiss\t_____\t1\n" +
+			"guess\ty\tmiss\t_____\t0\n" +
+			"lost\thello\t0\n"},
+		{name: "input ended", input: "e\n", err: ErrUnfinished, expected: "start\t_____\t6\t1\n" +
+			"guess\te\thit\t_e___\t6\n"},
+		{name: "quit", input: "QUIT\ne\n", err: ErrUnfinished, expected: "start\t_____\t6\t1\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var output bytes.Buffer
+			err := newTestGame("hello", testRules).BatchGame(strings.NewReader(test.input), &output)
+			if !errors.Is(err, test.err) {
+				t.Errorf("BatchGame = %v, expected %v", err, test.err)
+			}
+			if output.String() != test.expected {
+				t.Errorf("BatchGame wrote\n%s\nexpected\n%s", output.String(), test.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,402 @@
+// Command hangman is the hangman game in the terminal.
+//
+// Usage:
+//
+//	hangman [COMMAND] [OPTIONS] [ARGUMENTS]
+//
+// The commands are play (the default one), resume, rules, dict list, dict validate, stats and solve,
+// "hangman help COMMAND" gives the options of each one. When the first argument isn't a command,
+// the arguments are the ones of play: "hangman words.txt" plays with the dictionary words.txt.
+//
+// Exit codes:
+//
+//	0  success
+//	1  error (missing resource, unreadable save...)
+//	2  invalid command, option or argument
+//	3  dictionary with problems (dict validate) or no word matching the pattern (solve)
+//	4  game lost (--batch, --json)
+//	5  input ended before the end of the game (--batch, --json)
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/Talienhyung/hangman"
+)
+
+// Exit codes of the program
+const (
+	exitOK         = 0
+	exitError      = 1
+	exitUsage      = 2
+	exitNotFound   = 3
+	exitLost       = 4
+	exitUnfinished = 5
+)
+
+// Errors of the commands, they give their exit code
+var (
+	errUsage    = hangman.ErrInvalidArgument
+	errNotFound = errors.New("nothing found") // Never shown, the command already told what wasn't found
+)
+
+// A command of the program: "hangman <name> ..."
+type command struct {
+	name  string
+	args  string                          // Arguments after the options, for the usage
+	about string                          // Key of the description in the language packs
+	flags func(name string) *flag.FlagSet // Options of the command, nil if it has none
+	game  bool                            // True if the options are the ones of a game, they can also come from the settings
+	run   func(arguments []string) error  // Runs the command with the arguments after its name
+}
+
+var commands []command
+
+func init() { // The help command lists the commands, they are set here to refer to it
+	commands = []command{
+		{name: "play", args: "[DICTIONARY]", about: "cli.about.play", flags: gameFlags, game: true, run: play},
+		{name: "resume", args: "[SLOT]", about: "cli.about.resume", flags: resumeFlags, game: true, run: resume},
+		{name: "rules", about: "cli.about.rules", run: rules},
+		{name: "dict list", about: "cli.about.dictList", run: dictList},
+		{name: "dict validate", args: "[DICTIONARY...]", about: "cli.about.dictValidate", run: dictValidate},
+		{name: "stats", about: "cli.about.stats", run: stats},
+		{name: "solve", args: "PATTERN", about: "cli.about.solve", flags: solveFlags, run: solve},
+		{name: "help", args: "[COMMAND]", about: "cli.about.help", run: help},
+	}
+}
+
+func main() {
+	os.Exit(exitCode(run(os.Args[1:])))
+}
+
+// Runs the command of the arguments, play if no command is given
+func run(arguments []string) error {
+	setupErr := setup() // Only the commands that are not games need it, the help is shown in any case
+	if len(arguments) == 0 || strings.HasPrefix(arguments[0], "-") {
+		if len(arguments) != 0 && isHelp(arguments[0]) {
+			return help(nil)
+		}
+		return play(arguments)
+	}
+	cmd, arguments, ok := findCommand(arguments)
+	if !ok && isCommandGroup(arguments[0]) { // "hangman dict" without list or validate
+		return fmt.Errorf("%w: %s", errUsage, hangman.T("cli.unknownCommand", strings.Join(arguments, " ")))
+	}
+	if !ok { // The first argument is the dictionary of a game: "hangman words.txt"
+		return play(arguments)
+	}
+	if len(arguments) != 0 && isHelp(arguments[0]) {
+		usage(os.Stdout, cmd)
+		return nil
+	}
+	if !cmd.game && cmd.name != "help" && setupErr != nil { // The games read their settings with their options
+		return setupErr
+	}
+	return cmd.run(arguments)
+}
+
+// Reads the resources and the messages from the directory and in the language of the settings, for the commands that are not games
+func setup() error {
+	settings, err := hangman.LoadSettings()
+	if err != nil {
+		return err
+	}
+	if dir, ok := settings["resources"]; ok {
+		hangman.SetResourceDir(dir.Value)
+	}
+	locale := hangman.DetectLocale()
+	if lang, ok := settings["lang"]; ok {
+		locale = lang.Value
+	}
+	return hangman.SetLocale(locale)
+}
+
+// Return the command named by the first arguments and the arguments after its name
+func findCommand(arguments []string) (command, []string, bool) {
+	for _, cmd := range commands {
+		words := strings.Fields(cmd.name)
+		if len(arguments) >= len(words) && strings.Join(arguments[:len(words)], " ") == cmd.name {
+			return cmd, arguments[len(words):], true
+		}
+	}
+	return command{}, arguments, false
+}
+
+// Return true if the word is the first word of a command of several words, like dict
+func isCommandGroup(word string) bool {
+	for _, cmd := range commands {
+		if words := strings.Fields(cmd.name); len(words) > 1 && words[0] == word {
+			return true
+		}
+	}
+	return false
+}
+
+// Return true if the argument asks for the help
+func isHelp(argument string) bool {
+	return argument == "-h" || argument == "--help" || argument == "-help"
+}
+
+// Return the exit code of the error of a command
+func exitCode(err error) int {
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.Is(err, errNotFound): // The command already told what wasn't found
+		return exitNotFound
+	case errors.Is(err, hangman.ErrGameLost): // The batch and json modes already wrote the result
+		return exitLost
+	case errors.Is(err, hangman.ErrUnfinished):
+		return exitUnfinished
+	}
+	fmt.Fprintln(os.Stderr, "hangman:", err)
+	if errors.Is(err, errUsage) || hangman.IsUsageError(err) {
+		return exitUsage
+	}
+	return exitError
+}
+
+// Writes the usage of the command, with its options
+func usage(w io.Writer, cmd command) {
+	line := "hangman " + cmd.name
+	if cmd.flags != nil {
+		line += " [OPTIONS]"
+	}
+	if cmd.args != "" {
+		line += " " + cmd.args
+	}
+	fmt.Fprintf(w, "%s\n\n%s\n", hangman.T("cli.usage", line), capitalize(hangman.T(cmd.about)))
+	if cmd.flags != nil {
+		fmt.Fprintln(w, "\n"+hangman.T("cli.options"))
+		hangman.PrintFlags(w, cmd.flags("hangman "+cmd.name))
+	}
+	if cmd.game {
+		hangman.PrintSettings(w)
+	}
+}
+
+// Return the text with an upper case first letter and a final dot
+func capitalize(text string) string {
+	return strings.ToUpper(text[:1]) + text[1:] + "."
+}
+
+//########### Commands ##################
+
+// Options of the games, see hangman.Game
+func gameFlags(name string) *flag.FlagSet {
+	var game hangman.Game
+	return game.FlagSet(name)
+}
+
+func play(arguments []string) error {
+	game, err := hangman.ParseArguments(arguments)
+	if err != nil {
+		return err
+	}
+	return hangman.ExploitingArgument(game)
+}
+
+// Options of the resume command: the ones of a game and --import, the file to import is written in imported
+func resumeFlagSet(game *hangman.Game, imported *string, name string) *flag.FlagSet {
+	fs := game.FlagSet(name)
+	fs.StringVar(imported, "import", "", hangman.T("flag.import"))
+	fs.Var(fs.Lookup("import").Value, "im", "") // Same value, the short name has no usage
+	return fs
+}
+
+func resumeFlags(name string) *flag.FlagSet {
+	var game hangman.Game
+	var imported string
+	return resumeFlagSet(&game, &imported, name)
+}
+
+func resume(arguments []string) error {
+	var game hangman.Game
+	var imported string
+	fs := resumeFlagSet(&game, &imported, "hangman resume")
+	others, err := hangman.ParseFlags(fs, arguments)
+	if err != nil {
+		return err
+	}
+	settings, err := hangman.LoadSettings()
+	if err != nil {
+		return err
+	}
+	if err := game.Apply(settings, fs); err != nil {
+		return err
+	}
+	if len(others) > 1 { // A single slot
+		return fmt.Errorf("%w: %s", errUsage, hangman.T("cli.singleSlot"))
+	}
+	slot := ""
+	if len(others) == 1 {
+		slot = others[0]
+	}
+	if imported != "" && slot == "" {
+		slot = hangman.DefaultSlot
+	}
+	game.Resume(slot)
+	if err := game.Check(); err != nil {
+		return err
+	}
+	if imported != "" { // The old save is signed again in the slot, then resumed from it
+		if err := hangman.NewSlotManager(hangman.SaveDir).Import(slot, imported); err != nil {
+			return err
+		}
+	}
+	return hangman.ExploitingArgument(game)
+}
+
+func rules(arguments []string) error {
+	if len(arguments) != 0 {
+		return fmt.Errorf("%w: %s", errUsage, hangman.T("cli.noArgument", "rules"))
+	}
+	return hangman.DisplayRules()
+}
+
+func dictList(arguments []string) error {
+	if len(arguments) != 0 {
+		return fmt.Errorf("%w: %s", errUsage, hangman.T("cli.noArgument", "dict list"))
+	}
+	names, err := hangman.ListDictio()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		words, err := hangman.ReadFile("Dictionary/" + name)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\t%d\n", name, len(hangman.CleanDico(words))) // Fields for the scripts, not translated
+	}
+	return nil
+}
+
+func dictValidate(arguments []string) error {
+	names := arguments
+	if len(names) == 0 {
+		var err error
+		if names, err = hangman.ListDictio(); err != nil {
+			return err
+		}
+	}
+	found := false
+	for _, name := range names {
+		problems, err := hangman.ValidateDictionary(name)
+		if err != nil {
+			return err
+		}
+		for _, problem := range problems {
+			fmt.Println(problem)
+		}
+		found = found || len(problems) != 0
+	}
+	if found {
+		return errNotFound
+	}
+	return nil
+}
+
+func stats(arguments []string) error {
+	if len(arguments) != 0 {
+		return fmt.Errorf("%w: %s", errUsage, hangman.T("cli.noArgument", "stats"))
+	}
+	stats, err := hangman.ReadStats(hangman.StatsFile())
+	fmt.Println(stats) // The games that could be read, even if some lines couldn't
+	return err
+}
+
+// Options of the solve command
+type solveOptions struct {
+	used          string
+	dico          string
+	ignoreAccents bool
+	limit         int
+}
+
+// Return the options of the solve command, with their values in options
+func (options *solveOptions) flagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	option := func(long, short string) {
+		fs.Var(fs.Lookup(long).Value, short, "") // Same value, the short name has no usage
+	}
+	fs.StringVar(&options.used, "used", "", hangman.T("flag.solve.used"))
+	option("used", "u")
+	fs.StringVar(&options.dico, "dict", "", hangman.T("flag.solve.dict"))
+	option("dict", "d")
+	fs.BoolVar(&options.ignoreAccents, "ignoreAccents", false, hangman.T("flag.solve.ignoreAccents"))
+	option("ignoreAccents", "ia")
+	fs.IntVar(&options.limit, "limit", 20, hangman.T("flag.solve.limit"))
+	option("limit", "n")
+	return fs
+}
+
+func solveFlags(name string) *flag.FlagSet {
+	var options solveOptions
+	return options.flagSet(name)
+}
+
+func solve(arguments []string) error {
+	var options solveOptions
+	others, err := hangman.ParseFlags(options.flagSet("hangman solve"), arguments)
+	if err != nil {
+		return err
+	}
+	if len(others) != 1 {
+		return fmt.Errorf("%w: %s", errUsage, hangman.T("cli.singlePattern"))
+	}
+
+	var dico []string
+	if options.dico != "" {
+		dico, err = hangman.ReadFile("Dictionary/" + options.dico)
+	} else {
+		dico, err = hangman.ReadAllDico()
+	}
+	if err != nil {
+		return err
+	}
+	words, letter := hangman.Solve(hangman.CleanDico(dico), others[0], []rune(options.used), options.ignoreAccents)
+	if len(words) == 0 {
+		fmt.Println(hangman.T("cli.noMatch", others[0]))
+		return errNotFound
+	}
+	for i, word := range words {
+		if options.limit > 0 && i == options.limit {
+			fmt.Println(hangman.T("cli.more", len(words)-i))
+			break
+		}
+		fmt.Println(word)
+	}
+	if letter != 0 {
+		fmt.Println(hangman.T("cli.bestLetter", len(words), letter))
+	} else {
+		fmt.Println(hangman.T("cli.noLetter", len(words)))
+	}
+	return nil
+}
+
+func help(arguments []string) error {
+	if len(arguments) != 0 {
+		cmd, rest, ok := findCommand(arguments)
+		if !ok || len(rest) != 0 {
+			return fmt.Errorf("%w: %s", errUsage, hangman.T("cli.unknownCommand", strings.Join(arguments, " ")))
+		}
+		usage(os.Stdout, cmd)
+		return nil
+	}
+	fmt.Println(hangman.T("cli.usage", "hangman [COMMAND] [OPTIONS] [ARGUMENTS]"))
+	fmt.Println("\n" + hangman.T("cli.commands"))
+	for _, cmd := range commands {
+		fmt.Printf("  %s\n      %s\n", cmd.name, strings.ReplaceAll(hangman.T(cmd.about), "\n", "\n      "))
+	}
+	fmt.Println("\n" + hangman.T("cli.default"))
+	fmt.Println("\n" + hangman.T("cli.exitCodes"))
+	return nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Talienhyung/hangman"
+)
+
+// Keep the saves, the key and the settings of the test in a temporary directory
+func setupTest(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	saveDir, keyFile := hangman.SaveDir, hangman.KeyFile
+	hangman.SaveDir, hangman.KeyFile = filepath.Join(dir, "saves"), filepath.Join(dir, "save.key")
+	hangman.SetSaveKey(nil)
+	t.Cleanup(func() {
+		hangman.SaveDir, hangman.KeyFile = saveDir, keyFile
+		hangman.SetSaveKey(nil)
+	})
+	config := writeFile(t, dir, "config", "")
+	t.Setenv(hangman.ConfigEnv, config)
+	for _, variable := range os.Environ() {
+		if name, _, _ := strings.Cut(variable, "="); strings.HasPrefix(name, "HANGMAN_") && name != hangman.ConfigEnv {
+			t.Setenv(name, "")
+		}
+	}
+	return dir
+}
+
+// Write the file in the directory and return its path
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	fichier := filepath.Join(dir, name)
+	if err := os.WriteFile(fichier, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return fichier
+}
+
+// Run the command with the arguments, return its error and what it wrote on the standard output
+func runCommand(t *testing.T, arguments ...string) (string, error) {
+	t.Helper()
+	output, err := os.CreateTemp(t.TempDir(), "stdout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer output.Close()
+	stdout := os.Stdout
+	os.Stdout = output
+	err = run(arguments)
+	os.Stdout = stdout
+
+	if _, seekErr := output.Seek(0, io.SeekStart); seekErr != nil {
+		t.Fatal(seekErr)
+	}
+	written, readErr := io.ReadAll(output)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	return string(written), err
+}
+
+// Save of the first version: the game alone, without envelope nor signature
+const oldSave = `{"Word":[104,95,108,108,95],"ToFind":"hello","Attempts":7,"HangmanPositions":2,"ListWord":[],"ListLetter":[104,108]}`
+
+func TestResumeImport(t *testing.T) {
+	dir := setupTest(t)
+	old := writeFile(t, dir, "old.txt", oldSave)
+	guesses := writeFile(t, dir, "guesses.txt", "e\no\n")
+
+	if err := os.MkdirAll(hangman.SaveDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, hangman.SaveDir, "unsigned.txt", oldSave)
+	if _, err := runCommand(t, "resume", "--batch", "--input", guesses, "unsigned"); !errors.Is(err, hangman.ErrSaveTampered) {
+		t.Errorf("resume of an unsigned save = %v, expected ErrSaveTampered", err)
+	}
+
+	output, err := runCommand(t, "resume", "--import", old, "--batch", "--input", guesses, "old")
+	if err != nil {
+		t.Fatalf("resume --import = %v", err)
+	}
+	if !strings.Contains(output, "start\th_ll_\t7") || !strings.HasSuffix(output, "won\thello\t7\n") {
+		t.Errorf("resume --import wrote\n%s", output)
+	}
+
+	if _, err := runCommand(t, "resume", "--batch", "--input", guesses, "old"); !errors.Is(err, os.ErrNotExist) { // Deleted once won
+		t.Errorf("second resume of the won game = %v, expected os.ErrNotExist", err)
+	}
+
+	if _, err := runCommand(t, "resume", "-im", old, "--batch", "--input", guesses); err != nil { // In the default slot
+		t.Errorf("resume -im without slot = %v", err)
+	}
+
+	if err := hangman.NewSlotManager(hangman.SaveDir).Save("taken", hangman.HangManData{ToFind: "word", Word: []rune("____"), Attempts: 3}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = runCommand(t, "resume", "--import", old, "--batch", "--input", guesses, "taken")
+	if !errors.Is(err, hangman.ErrSlotExists) || exitCode(err) != exitUsage {
+		t.Errorf("resume --import in a used slot = %v, expected ErrSlotExists", err)
+	}
+}
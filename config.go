@@ -0,0 +1,205 @@
+package hangman
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// The options of a game are taken, from the first to the last one that has them:
+//   - the command line (see FlagSet)
+//   - the HANGMAN_* environment variables, for example HANGMAN_THEME=light
+//   - the configuration file (see ConfigFile), for example "theme = light"
+//   - the default values of the options
+//
+// The settings are the keys of settingFlags, the environment variable of a key is HANGMAN_ followed by the key in upper case.
+
+// Environment variable giving the path of the configuration file
+const ConfigEnv = "HANGMAN_CONFIG"
+
+// Option of the command line set by each key of the settings, the mode sets one of the mode options (see applyMode)
+var settingFlags = map[string]string{
+	"dictionary":    "", // The dictionary isn't an option, it is the argument of the command line
+	"mode":          "",
+	"font":          "letterFile",
+	"hangman":       "hangmanFile",
+	"theme":         "theme",
+	"difficulty":    "difficulty",
+	"lang":          "lang",
+	"resources":     "resources",
+	"player":        "player",
+	"ignoreaccents": "ignoreAccents",
+	"autosave":      "autosave",
+	"norepeat":      "noRepeat",
+}
+
+// A value of the settings and where it comes from
+type Setting struct {
+	Value  string
+	Origin string // "file:line" or name of the environment variable
+}
+
+// Settings of the configuration file and the environment, by key
+type Settings map[string]Setting
+
+// Return the path of the configuration file: the one of HANGMAN_CONFIG, otherwise "hangman/config" in the user's configuration directory
+func ConfigFile() string {
+	if fichier := os.Getenv(ConfigEnv); fichier != "" {
+		return fichier
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "hangman", "config")
+}
+
+// Return the settings of the configuration file, replaced by the ones of the environment.
+// The default configuration file can be missing, a file given with HANGMAN_CONFIG can't.
+func LoadSettings() (Settings, error) {
+	settings := Settings{}
+	if fichier := ConfigFile(); fichier != "" {
+		fromFile, err := ReadConfig(fichier)
+		if err != nil && (os.Getenv(ConfigEnv) != "" || !errors.Is(err, os.ErrNotExist)) {
+			return nil, err
+		}
+		for key, setting := range fromFile {
+			settings[key] = setting
+		}
+	}
+	for key := range settingFlags {
+		variable := "HANGMAN_" + strings.ToUpper(key)
+		if value, ok := os.LookupEnv(variable); ok && value != "" {
+			settings[key] = Setting{Value: value, Origin: variable}
+		}
+	}
+	return settings, nil
+}
+
+// This function reads a configuration file of "key = value" lines (see readKeyValues).
+// The keys are the ones of settingFlags, without case ("ignoreAccents = true").
+func ReadConfig(fichier string) (Settings, error) {
+	readFile, err := os.Open(fichier)
+	if err != nil {
+		return nil, err
+	}
+	defer readFile.Close()
+
+	entries, err := readKeyValues(readFile, fichier)
+	if err != nil {
+		return nil, err
+	}
+	settings := Settings{}
+	for _, entry := range entries {
+		key := strings.ToLower(entry.Key)
+		if _, ok := settingFlags[key]; !ok {
+			return nil, &ResourceError{File: fichier, Line: entry.Line, Err: localErrorf("resource.unknownSetting", key, strings.Join(settingKeys(), ", "))}
+		}
+		settings[key] = Setting{Value: entry.Value, Origin: fmt.Sprintf("%s:%d", fichier, entry.Line)}
+	}
+	return settings, nil
+}
+
+// Return the keys of the settings in alphabetical order
+func settingKeys() []string {
+	keys := make([]string, 0, len(settingFlags))
+	for key := range settingFlags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Apply gives the settings to the options of the flag set that were not given on the command line.
+// The settings are for every mode: the font and the theme are left out if the mode doesn't use them.
+func (game *Game) Apply(settings Settings, fs *flag.FlagSet) error {
+	given := map[flag.Value]bool{} // A short name has the value of its long name
+	fs.Visit(func(f *flag.Flag) {
+		given[f.Value] = true
+	})
+
+	if setting, ok := settings["mode"]; ok { // The mode first, the other settings depend on it
+		if err := game.applyMode(setting.Value, fs, given); err != nil {
+			return fmt.Errorf("%w: %s: mode: %v", ErrInvalidArgument, setting.Origin, err)
+		}
+	}
+	for _, key := range settingKeys() {
+		setting, ok := settings[key]
+		if !ok || key == "mode" {
+			continue
+		}
+		var err error
+		switch key {
+		case "dictionary":
+			if game.dico == "" {
+				game.dico = setting.Value
+			}
+		case "font":
+			if !game.classic && !game.accessible && !game.scripted() {
+				err = game.applyFlag(fs, given, key, setting.Value)
+			}
+		case "theme":
+			if !game.classic && !game.ascii && !game.accessible && !game.scripted() {
+				err = game.applyFlag(fs, given, key, setting.Value)
+			}
+		default:
+			err = game.applyFlag(fs, given, key, setting.Value)
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %s: %s: %v", ErrInvalidArgument, setting.Origin, key, err)
+		}
+	}
+	return nil
+}
+
+// Sets the option of the key to the value, unless it was given on the command line
+func (game *Game) applyFlag(fs *flag.FlagSet, given map[flag.Value]bool, key, value string) error {
+	f := fs.Lookup(settingFlags[key])
+	if f == nil || given[f.Value] {
+		return nil
+	}
+	if err := fs.Set(f.Name, value); err != nil {
+		return localErrorf("resource.invalidValue", value)
+	}
+	return nil
+}
+
+// Sets the mode option of the mode (termbox, classic, ascii, accessible, batch or json), unless a mode was given on the command line
+func (game *Game) applyMode(mode string, fs *flag.FlagSet, given map[flag.Value]bool) error {
+	modes := []string{"classic", "ascii", "accessible", "batch", "json"}
+	for _, name := range modes {
+		if given[fs.Lookup(name).Value] {
+			return nil
+		}
+	}
+	mode = strings.ToLower(mode)
+	if mode == "termbox" {
+		return nil
+	}
+	for _, name := range modes {
+		if mode == name {
+			return fs.Set(name, "true")
+		}
+	}
+	return localErrorf("resource.unknownMode", mode)
+}
+
+// PrintSettings writes where the options can also be given, and which option takes precedence
+func PrintSettings(w io.Writer) {
+	fmt.Fprintf(w, "\n%s\n", T("settings.about", ConfigFile(), ConfigEnv))
+	for _, key := range settingKeys() {
+		about := T("settings.option", settingFlags[key])
+		switch key {
+		case "dictionary":
+			about = T("settings.dictionary")
+		case "mode":
+			about = T("settings.mode")
+		}
+		fmt.Fprintf(w, "    %-14s %-23s %s\n", key, "HANGMAN_"+strings.ToUpper(key), about)
+	}
+}
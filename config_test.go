@@ -0,0 +1,118 @@
+package hangman
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Use the configuration file of the test and the environment variables, the other HANGMAN_* variables are emptied
+func setTestSettings(t *testing.T, config string, env map[string]string) {
+	t.Helper()
+	fichier := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(fichier, []byte(config), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(ConfigEnv, fichier)
+	for key := range settingFlags {
+		t.Setenv("HANGMAN_"+strings.ToUpper(key), env[key])
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    string
+		env       map[string]string
+		arguments []string
+		expected  Game
+	}{
+		{name: "defaults", expected: Game{}},
+		{name: "file", config: "theme = light\n# comment\nDifficulty = hard\nignoreAccents = true\ndictionary = words.txt\n",
+			expected: Game{theme: "light", difficulty: "hard", noAccent: true, dico: "words.txt"}},
+		{name: "environment over file", config: "theme = light\ndifficulty = hard\n", env: map[string]string{"theme": "dark"},
+			expected: Game{theme: "dark", difficulty: "hard"}},
+		{name: "command line over environment", config: "theme = light\n", env: map[string]string{"theme": "dark", "player": "bob"},
+			arguments: []string{"--theme", "high-contrast"},
+			expected:  Game{theme: "high-contrast", player: "bob"}},
+		{name: "short name", env: map[string]string{"difficulty": "easy"}, arguments: []string{"-d", "hard"},
+			expected: Game{difficulty: "hard"}},
+		{name: "dictionary argument", config: "dictionary = words.txt\n", arguments: []string{"other.txt"},
+			expected: Game{dico: "other.txt"}},
+		{name: "mode", config: "mode = classic\n", expected: Game{classic: true}},
+		{name: "mode of the command line", config: "mode = classic\n", arguments: []string{"--ascii"},
+			expected: Game{ascii: true}},
+		{name: "termbox mode", env: map[string]string{"mode": "termbox", "theme": "light"}, expected: Game{theme: "light"}},
+		{name: "settings of another mode", config: "font = big.txt\ntheme = light\n", env: map[string]string{"mode": "batch"},
+			expected: Game{batch: true}},
+		{name: "font of the ascii mode", config: "font = big.txt\ntheme = light\nmode = ascii\n",
+			expected: Game{ascii: true, letterFile: "big.txt"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setTestSettings(t, test.config, test.env)
+			var game Game
+			fs := game.FlagSet("hangman")
+			others, err := ParseFlags(fs, test.arguments)
+			if err != nil {
+				t.Fatalf("ParseFlags = %v", err)
+			}
+			if len(others) == 1 {
+				game.SetDictionary(others[0])
+			}
+			settings, err := LoadSettings()
+			if err != nil {
+				t.Fatalf("LoadSettings = %v", err)
+			}
+			if err := game.Apply(settings, fs); err != nil {
+				t.Fatalf("Apply = %v", err)
+			}
+			if game != test.expected {
+				t.Errorf("Apply = %+v, expected %+v", game, test.expected)
+			}
+		})
+	}
+}
+
+func TestApplyErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		env    map[string]string
+		line   int // Line of the ResourceError of the file, 0 if the error comes from Apply
+	}{
+		{name: "unknown key", config: "theme = light\ncolor = red\n", line: 2},
+		{name: "not a setting", config: "# comment\n\ntheme\n", line: 3},
+		{name: "invalid value", config: "autosave = maybe\n"},
+		{name: "unknown mode", env: map[string]string{"mode": "graphic"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setTestSettings(t, test.config, test.env)
+			settings, err := LoadSettings()
+			if test.line != 0 {
+				var resourceErr *ResourceError
+				if !errors.As(err, &resourceErr) || resourceErr.Line != test.line {
+					t.Fatalf("LoadSettings = %v, expected an error at the line %d", err, test.line)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadSettings = %v", err)
+			}
+			var game Game
+			if err := game.Apply(settings, game.FlagSet("hangman")); !errors.Is(err, ErrInvalidArgument) {
+				t.Errorf("Apply = %v, expected ErrInvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestMissingConfig(t *testing.T) {
+	t.Setenv(ConfigEnv, filepath.Join(t.TempDir(), "missing"))
+	if _, err := LoadSettings(); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("LoadSettings with a missing HANGMAN_CONFIG = %v, expected os.ErrNotExist", err)
+	}
+}
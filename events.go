@@ -0,0 +1,117 @@
+package hangman
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Types of the events written by EventRenderer
+const (
+	EventStarted        = "started"         // Before the first guess
+	EventGuessAccepted  = "guess_accepted"  // A guess has been played
+	EventGuessRejected  = "guess_rejected"  // A guess has been refused, see Reason
+	EventLetterRevealed = "letter_revealed" // A letter of the word has been found, after its guess
+	EventAttemptLost    = "attempt_lost"    // A guess was wrong, after it
+	EventWon            = "won"             // The word has been found
+	EventLost           = "lost"            // No more attempts
+	EventSaved          = "saved"           // The game has been saved with STOP
+	EventMessage        = "message"         // Information, such as a failed autosave
+)
+
+// Event of a game, written as a JSON line. The fields that don't concern the event are left out.
+type Event struct {
+	Type      string    `json:"type"`                // One of the Event* constants
+	Time      time.Time `json:"time"`                // Date of the event
+	Word      string    `json:"word"`                // Word as shown, '_' for the letters to find
+	Attempts  int       `json:"attempts"`            // Attempts left
+	Input     string    `json:"input,omitempty"`     // Guess of the player
+	Reason    string    `json:"reason,omitempty"`    // Why the guess was refused: invalid or repeat
+	Letter    string    `json:"letter,omitempty"`    // Letter revealed
+	Positions []int     `json:"positions,omitempty"` // Index in the word of the revealed letter
+	Cost      int       `json:"cost,omitempty"`      // Attempts lost
+	Answer    string    `json:"answer,omitempty"`    // Word to find, once the game is over
+	Slot      string    `json:"slot,omitempty"`      // Save slot
+	Seed      int64     `json:"seed,omitempty"`      // Seed of the game, --seed replays it (started)
+	Message   string    `json:"message,omitempty"`   // Text of the message
+}
+
+// EventRenderer writes the events of the game as JSON lines, one object per event, for the programs that follow the game
+type EventRenderer struct {
+	encoder *json.Encoder
+	hang    *HangManData // Game of the last event, for the messages
+	Now     func() time.Time
+}
+
+// Return a renderer writing the events in w, the events are dated with time.Now
+func NewEventRenderer(w io.Writer) *EventRenderer {
+	return &EventRenderer{encoder: json.NewEncoder(w), Now: time.Now}
+}
+
+// Writes the event, with the state of the game
+func (events *EventRenderer) write(hang *HangManData, event Event) error {
+	events.hang = hang
+	event.Time = events.Now().UTC()
+	if hang != nil {
+		event.Word, event.Attempts = string(hang.Word), hang.Attempts
+	}
+	return events.encoder.Encode(event)
+}
+
+func (events *EventRenderer) Start(hang *HangManData) error {
+	return events.write(hang, Event{Type: EventStarted, Seed: hang.Seed})
+}
+
+func (events *EventRenderer) Guess(hang *HangManData, result GuessResult) error {
+	if err := events.write(hang, Event{Type: EventGuessAccepted, Input: result.Input}); err != nil {
+		return err
+	}
+	if result.Cost > 0 {
+		return events.write(hang, Event{Type: EventAttemptLost, Input: result.Input, Cost: result.Cost})
+	}
+
+	positions := map[rune][]int{} // A word reveals several letters
+	for _, index := range result.Positions {
+		letter := hang.foldRune(hang.Word[index])
+		positions[letter] = append(positions[letter], index)
+	}
+	letters := make([]rune, 0, len(positions))
+	for letter := range positions {
+		letters = append(letters, letter)
+	}
+	sort.Slice(letters, func(i, j int) bool { return positions[letters[i]][0] < positions[letters[j]][0] })
+	for _, letter := range letters {
+		event := Event{Type: EventLetterRevealed, Input: result.Input, Letter: string(unicode.ToUpper(letter)), Positions: positions[letter]}
+		if err := events.write(hang, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (events *EventRenderer) Reject(hang *HangManData, input string, err error) error {
+	reason := "invalid"
+	if errors.Is(err, ErrAlreadyGuessed) {
+		reason = "repeat"
+	}
+	return events.write(hang, Event{Type: EventGuessRejected, Input: strings.TrimSpace(input), Reason: reason})
+}
+
+func (events *EventRenderer) Message(text string) {
+	events.write(events.hang, Event{Type: EventMessage, Message: text})
+}
+
+func (events *EventRenderer) Saved(hang *HangManData, slot string) error {
+	return events.write(hang, Event{Type: EventSaved, Slot: slot})
+}
+
+func (events *EventRenderer) End(hang *HangManData) error {
+	if hang.Status() == Won {
+		return events.write(hang, Event{Type: EventWon, Answer: hang.ToFind})
+	}
+	return events.write(hang, Event{Type: EventLost, Answer: hang.ToFind})
+}
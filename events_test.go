@@ -0,0 +1,42 @@
+package hangman
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJSONGame(t *testing.T) {
+	date := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	var output bytes.Buffer
+	events := NewEventRenderer(&output)
+	events.Now = func() time.Time { return date }
+
+	hang := newTestGame("hello", testRules)
+	if err := hang.script(events, strings.NewReader("l\nz\nl\nhello\n")); err != nil {
+		t.Fatalf("script = %v", err)
+	}
+	expected := []string{
+		`{"type":"started","time":"2024-01-02T03:04:05Z","word":"_____","attempts":6,"seed":1}`,
+		`{"type":"guess_accepted","time":"2024-01-02T03:04:05Z","word":"__ll_","attempts":6,"input":"l"}`,
+		`{"type":"letter_revealed","time":"2024-01-02T03:04:05Z","word":"__ll_","attempts":6,"input":"l","letter":"L","positions":[2,3]}`,
+		`{"type":"guess_accepted","time":"2024-01-02T03:04:05Z","word":"__ll_","attempts":5,"input":"z"}`,
+		`{"type":"attempt_lost","time":"2024-01-02T03:04:05Z","word":"__ll_","attempts":5,"input":"z","cost":1}`,
+		`{"type":"guess_rejected","time":"2024-01-02T03:04:05Z","word":"__ll_","attempts":5,"input":"l","reason":"repeat"}`,
+		`{"type":"guess_accepted","time":"2024-01-02T03:04:05Z","word":"hello","attempts":5,"input":"hello"}`,
+		`{"type":"letter_revealed","time":"2024-01-02T03:04:05Z","word":"hello","attempts":5,"input":"hello","letter":"H","positions":[0]}`,
+		`{"type":"letter_revealed","time":"2024-01-02T03:04:05Z","word":"hello","attempts":5,"input":"hello","letter":"E","positions":[1]}`,
+		`{"type":"letter_revealed","time":"2024-01-02T03:04:05Z","word":"hello","attempts":5,"input":"hello","letter":"O","positions":[4]}`,
+		`{"type":"won","time":"2024-01-02T03:04:05Z","word":"hello","attempts":5,"answer":"hello"}`,
+	}
+	lines := strings.Split(strings.TrimSuffix(output.String(), "\n"), "\n")
+	if len(lines) != len(expected) {
+		t.Fatalf("JSON game wrote %d events, expected %d:\n%s", len(lines), len(expected), output.String())
+	}
+	for i := range expected {
+		if lines[i] != expected[i] {
+			t.Errorf("event %d = %s, expected %s", i, lines[i], expected[i])
+		}
+	}
+}
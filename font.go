@@ -0,0 +1,177 @@
+package hangman
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Font of the ascii art: every character is a drawing of Height lines
+type Font struct {
+	Height int
+	glyphs map[rune][]string
+}
+
+// Characters after the ascii ones in every FIGlet font, in this order
+var figletDeutsch = []rune{'Ä', 'Ö', 'Ü', 'ä', 'ö', 'ü', 'ß'}
+
+// Read the font of the given resource: a FIGlet font if the name ends with .flf, otherwise the font of 9 lines of the hangman
+func LoadFont(fichier string) (*Font, error) {
+	if strings.HasSuffix(fichier, ".flf") {
+		return ReadFiglet(fichier)
+	}
+	ascii, err := ReadAscii(fichier)
+	if err != nil {
+		return nil, err
+	}
+	return FontFromAscii(ascii), nil
+}
+
+// Return the font of a [95][9]string, the empty line before each character is dropped
+func FontFromAscii(ascii [95][9]string) *Font {
+	font := &Font{Height: len(ascii[0]) - 1, glyphs: map[rune][]string{}}
+	for i := range ascii {
+		font.glyphs[rune(i+32)] = append([]string(nil), ascii[i][1:]...)
+	}
+	return font
+}
+
+// This function reads a FIGlet font (.flf): the header, the comments, the ascii characters,
+// then the optional german ones and the characters given by their code.
+// The endmarks are removed and the hardblanks become spaces, the characters are drawn at full width.
+func ReadFiglet(fichier string) (*Font, error) {
+	lines, err := readLines(fichier)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, &ResourceError{File: fichier, Err: localErrorf("resource.emptyFont")}
+	}
+
+	// Header: flf2a<hardblank> height baseline maxLength oldLayout commentLines ...
+	fields := strings.Fields(lines[0])
+	if len(fields) < 6 || !strings.HasPrefix(fields[0], "flf2a") || utf8.RuneCountInString(fields[0]) != 6 {
+		return nil, &ResourceError{File: fichier, Line: 1, Err: localErrorf("resource.figletHeader")}
+	}
+	hardblank, _ := utf8.DecodeLastRuneInString(fields[0])
+	height, err := strconv.Atoi(fields[1])
+	if err != nil || height < 1 {
+		return nil, &ResourceError{File: fichier, Line: 1, Err: localErrorf("resource.invalidHeight", fields[1])}
+	}
+	comments, err := strconv.Atoi(fields[5])
+	if err != nil || comments < 0 {
+		return nil, &ResourceError{File: fichier, Line: 1, Err: localErrorf("resource.invalidComments", fields[5])}
+	}
+
+	font := &Font{Height: height, glyphs: map[rune][]string{}}
+	index := 1 + comments
+
+	// Read the drawing starting at index, the index moves after it
+	glyph := func(char rune) ([]string, error) {
+		if index+height > len(lines) {
+			return nil, &ResourceError{File: fichier, Line: len(lines) + 1, Err: localErrorf("resource.shortCharacter", char, height)}
+		}
+		drawing := make([]string, height)
+		for i, line := range lines[index : index+height] {
+			endmark, _ := utf8.DecodeLastRuneInString(line)
+			line = strings.TrimRight(line, string(endmark))
+			drawing[i] = strings.ReplaceAll(line, string(hardblank), " ")
+		}
+		index += height
+		return drawing, nil
+	}
+
+	for char := rune(32); char <= 126; char++ {
+		drawing, err := glyph(char)
+		if err != nil {
+			return nil, err
+		}
+		font.glyphs[char] = drawing
+	}
+	for _, char := range figletDeutsch { // Older fonts stop after the ascii characters
+		if index >= len(lines) {
+			return font, nil
+		}
+		drawing, err := glyph(char)
+		if err != nil {
+			return nil, err
+		}
+		font.glyphs[char] = drawing
+	}
+	for index < len(lines) { // Code tagged characters: "code description" then the drawing
+		if strings.TrimSpace(lines[index]) == "" {
+			index++
+			continue
+		}
+		tag := strings.Fields(lines[index])
+		code, err := strconv.ParseInt(tag[0], 0, 32) // Decimal, 0x hexadecimal or 0 octal
+		if err != nil {
+			return nil, &ResourceError{File: fichier, Line: index + 1, Err: localErrorf("resource.invalidCode", tag[0])}
+		}
+		index++
+		drawing, err := glyph(rune(code))
+		if err != nil {
+			return nil, err
+		}
+		if code >= 0 { // The negative codes are not characters
+			font.glyphs[rune(code)] = drawing
+		}
+	}
+
+	return font, nil
+}
+
+// Return the drawing of the character: without its accent if the font hasn't it, '?' if it is still missing
+func (font *Font) Glyph(char rune) []string {
+	if drawing, ok := font.glyphs[char]; ok {
+		return drawing
+	}
+	if drawing, ok := font.glyphs[RemoveAccent(char)]; ok {
+		return drawing
+	}
+	if drawing, ok := font.glyphs['?']; ok {
+		return drawing
+	}
+	return make([]string, font.Height)
+}
+
+// Return true if the font has a drawing for the character
+func (font *Font) Has(char rune) bool {
+	_, ok := font.glyphs[char]
+	return ok
+}
+
+// Return the width of the text drawn with the font
+func (font *Font) Width(text string) int {
+	width := 0
+	for _, char := range text {
+		width += glyphWidth(font.Glyph(char))
+	}
+	return width
+}
+
+// Return the lines of the text drawn with the font, each character is as wide as its widest line
+func (font *Font) Render(text string) []string {
+	lines := make([]string, font.Height)
+	for _, char := range text {
+		drawing := font.Glyph(char)
+		width := glyphWidth(drawing)
+		for i := range lines {
+			line := ""
+			if i < len(drawing) {
+				line = drawing[i]
+			}
+			lines[i] += line + strings.Repeat(" ", width-utf8.RuneCountInString(line))
+		}
+	}
+	return lines
+}
+
+// Return the width of the widest line of the drawing
+func glyphWidth(drawing []string) int {
+	width := 0
+	for _, line := range drawing {
+		width = max(width, utf8.RuneCountInString(line))
+	}
+	return width
+}
@@ -0,0 +1,75 @@
+package hangman
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// Return a FIGlet font of the given height with the characters from ' ' to last
+func figletFont(height int, last rune) string {
+	lines := []string{fmt.Sprintf("flf2a$ %d 1 10 0 1", height), "a comment"}
+	for char := ' '; char <= last; char++ {
+		for i := 0; i < height; i++ {
+			lines = append(lines, "$"+string(char)+"@")
+		}
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func TestReadFiglet(t *testing.T) {
+	deutsch := strings.Repeat("$ä@\n", 2*len(figletDeutsch))
+	tests := []struct {
+		name    string
+		content string
+		line    int // Line of the ResourceError, 0 for the whole file, -1 for no error
+	}{
+		{"font", figletFont(2, '~'), -1},
+		{"german characters", figletFont(2, '~') + deutsch, -1},
+		{"code tagged", figletFont(2, '~') + deutsch + "0x263A smiley\n$:)@\n$:)@@\n\n-1 not a character\n$@\n$@\n", -1},
+		{"header", "flf2 2 1 10 0 0\n", 1},
+		{"height", "flf2a$ 0 1 10 0 0\n", 1},
+		{"comments", "flf2a$ 2 1 10 0 x\n", 1},
+		{"missing characters", figletFont(2, '}'), 191},
+		{"code", figletFont(2, '~') + deutsch + "x tagged\n$@\n$@\n", 207},
+		{"empty", "", 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setTestResources(t, fstest.MapFS{"font.flf": {Data: []byte(test.content)}})
+			font, err := LoadFont("font.flf")
+			checkResourceError(t, err, test.line)
+			if err != nil {
+				return
+			}
+			if font.Height != 2 || !slices.Equal(font.Glyph('A'), []string{" A", " A"}) {
+				t.Errorf("LoadFont gave %d lines and %q for 'A'", font.Height, font.Glyph('A'))
+			}
+			if font.Has('ä') != strings.Contains(test.content, "ä") {
+				t.Errorf("Has('ä') = %v", font.Has('ä'))
+			}
+			if strings.Contains(test.content, "smiley") && !slices.Equal(font.Glyph('☺'), []string{" :)", " :)"}) {
+				t.Errorf("Glyph('☺') = %q", font.Glyph('☺'))
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	setTestResources(t, fstest.MapFS{"font.flf": {Data: []byte(figletFont(2, '~'))}})
+	font, err := LoadFont("font.flf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := font.Render("Aé"); !slices.Equal(lines, []string{" A e", " A e"}) { // 'é' is drawn as 'e'
+		t.Errorf("Render = %q", lines)
+	}
+	if width := font.Width("Aé"); width != 4 {
+		t.Errorf("Width = %d, expected 4", width)
+	}
+	if drawing := font.Glyph('☺'); !slices.Equal(drawing, font.Glyph('?')) {
+		t.Errorf("Glyph of a missing character = %q, expected the drawing of '?'", drawing)
+	}
+}
@@ -2,7 +2,6 @@ module github.com/Talienhyung/hangman
 
 go 1.21.0
 
-require (
-	github.com/mattn/go-runewidth v0.0.9 // indirect
-	github.com/nsf/termbox-go v1.1.1 // indirect
-)
+require github.com/nsf/termbox-go v1.1.1
+
+require github.com/mattn/go-runewidth v0.0.9 // indirect
@@ -0,0 +1,111 @@
+package hangman
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Directory of the hangman drawings in the resources
+const hangmanDir = "HangMan_Position"
+
+// Drawings of the hangman used when no other one is chosen
+const DefaultHangman = "hangman.txt"
+
+// HangmanArt is a pack of hangman drawings, from the empty gallows to the hanged man
+type HangmanArt struct {
+	Name   string            // "name" of the metadata, the file name if not given
+	Author string            // "author" of the metadata
+	Height int               // Lines of each frame
+	Meta   map[string]string // Every metadata of the file
+	Frames [][]string        // Drawings in order, each one has Height lines
+}
+
+// This function reads the given hangman file. It starts with optional metadata lines "# key: value",
+// then come the frames of "height" lines (7 if not given), each one followed by an empty line.
+// Any number of frames is read, the last empty line is optional.
+func ReadHang(fichier string) (*HangmanArt, error) {
+	lines, err := readLines(fichier)
+	if err != nil {
+		return nil, err
+	}
+
+	art := &HangmanArt{Name: fichier[strings.LastIndex(fichier, "/")+1:], Height: 7, Meta: map[string]string{}}
+	index := 0
+	for ; index < len(lines) && strings.HasPrefix(lines[index], "#"); index++ { // Metadata
+		key, value, ok := strings.Cut(strings.TrimPrefix(lines[index], "#"), ":")
+		if !ok {
+			continue // A comment
+		}
+		key, value = strings.ToLower(strings.TrimSpace(key)), strings.TrimSpace(value)
+		art.Meta[key] = value
+		switch key {
+		case "name":
+			art.Name = value
+		case "author":
+			art.Author = value
+		case "height":
+			art.Height, err = strconv.Atoi(value)
+			if err != nil || art.Height < 1 {
+				return nil, &ResourceError{File: fichier, Line: index + 1, Err: localErrorf("resource.invalidHeight", value)}
+			}
+		}
+	}
+
+	lines = trimEmptyEnd(lines[index:], 0)
+	if len(lines) == 0 {
+		return nil, &ResourceError{File: fichier, Err: localErrorf("resource.noFrame")}
+	}
+	lines = append(lines, "") // The empty line after the last frame is optional
+
+	// Browse each frame of the file.
+	for i := 0; i*(art.Height+1) < len(lines); i++ {
+		start := i * (art.Height + 1)
+		if start+art.Height >= len(lines) {
+			return nil, &ResourceError{File: fichier, Line: index + len(lines), Err: localErrorf("resource.shortFrame", i+1, art.Height)}
+		}
+		if strings.TrimSpace(lines[start+art.Height]) != "" { // A frame that isn't height lines high moves the empty lines
+			return nil, &ResourceError{File: fichier, Line: index + start + art.Height + 1, Err: localErrorf("resource.frameEnd", i+1)}
+		}
+		art.Frames = append(art.Frames, lines[start:start+art.Height])
+	}
+	if frames, ok := art.Meta["frames"]; ok && frames != strconv.Itoa(len(art.Frames)) {
+		return nil, &ResourceError{File: fichier, Err: localErrorf("resource.frameCount", len(art.Frames), frames)}
+	}
+
+	return art, nil
+}
+
+// Return the width of the widest frame
+func (art *HangmanArt) Width() int {
+	width := 0
+	for _, frame := range art.Frames {
+		width = max(width, glyphWidth(frame))
+	}
+	return width
+}
+
+// Return the frame matching the attempts lost, -1 if none is lost yet.
+// The frames are spread over the attempts of the rules, the last one is reached when no attempt is left.
+func (hang *HangManData) Frame(frames int) int {
+	total := hang.Rules.Attempts
+	lost := total - hang.Attempts
+	if total <= 0 { // Rules without attempts, the hangman goes forward one frame by attempt lost
+		lost = hang.HangmanPositions + 1
+		total = frames
+	}
+	if lost <= 0 || frames <= 0 {
+		return -1
+	}
+	if lost >= total || total == 1 { // Only the last attempt shows the last frame
+		return frames - 1
+	}
+	return (lost - 1) * (frames - 1) / (total - 1) // The first attempt lost shows the first frame
+}
+
+// Return the hangman drawings of the game
+func (hang *HangManData) art() (*HangmanArt, error) {
+	if hang.HangmanFile == "" {
+		return DefaultAssets.Hangman(hangmanDir + "/" + DefaultHangman)
+	}
+	return DefaultAssets.Hangman(hangmanDir + "/" + hang.HangmanFile)
+}
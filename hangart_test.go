@@ -0,0 +1,63 @@
+package hangman
+
+import (
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+func TestReadHang(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		line    int // Line of the ResourceError, 0 for the whole file, -1 for no error
+	}{
+		{"hangman", "# name: test\n# author: me\n# height: 2\n# frames: 2\na\nb\n\nc\nd\n", -1},
+		{"invalid height", "# name: test\n# height: none\na\n", 2},
+		{"frame too high", "# height: 2\na\nb\nc\n\nd\ne\n", 4},
+		{"last frame too short", "# height: 2\na\nb\n\nc\n", 6},
+		{"frame count", "# height: 1\n# frames: 3\na\n\nb\n", 0},
+		{"without frame", "# name: test\n\n", 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setTestResources(t, fstest.MapFS{"HangMan_Position/test.txt": {Data: []byte(test.content)}})
+			art, err := ReadHang("HangMan_Position/test.txt")
+			checkResourceError(t, err, test.line)
+			if err != nil {
+				return
+			}
+			if art.Name != "test" || art.Author != "me" || art.Height != 2 || len(art.Frames) != 2 || !slices.Equal(art.Frames[1], []string{"c", "d"}) {
+				t.Errorf("ReadHang = %+v", art)
+			}
+		})
+	}
+
+	setTestResources(t, fstest.MapFS{"HangMan_Position/plain.txt": {Data: []byte("1\n2\n3\n4\n5\n6\n7\n")}})
+	art, err := ReadHang("HangMan_Position/plain.txt")
+	if err != nil || art.Name != "plain.txt" || art.Height != 7 || len(art.Frames) != 1 {
+		t.Errorf("ReadHang without metadata = %+v, %v, expected one frame of 7 lines named plain.txt", art, err)
+	}
+}
+
+func TestFrame(t *testing.T) {
+	tests := []struct {
+		attempts int
+		frames   int
+		expected []int // Frame for 0, 1, ... attempts lost
+	}{
+		{10, 10, []int{-1, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{12, 10, []int{-1, 0, 0, 1, 2, 3, 4, 4, 5, 6, 7, 8, 9}},
+		{6, 10, []int{-1, 0, 1, 3, 5, 7, 9}},
+		{1, 10, []int{-1, 9}},
+	}
+	for _, test := range tests {
+		hang := newTestGame("hello", Rules{Attempts: test.attempts, LetterCost: 1})
+		for lost, expected := range test.expected {
+			hang.Attempts = test.attempts - lost
+			if frame := hang.Frame(test.frames); frame != expected {
+				t.Errorf("Frame(%d) with %d of %d attempts lost = %d, expected %d", test.frames, lost, test.attempts, frame, expected)
+			}
+		}
+	}
+}
@@ -2,12 +2,17 @@ package hangman
 
 import (
 	"bufio"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
+	"io"
+	"io/fs"
 	"math/rand"
 	"os"
-	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/nsf/termbox-go"
@@ -17,194 +22,249 @@ type HangManData struct {
 	Word             []rune   // Word composed of '_', ex: H_ll_
 	ToFind           string   // Final word chosen by the program at the beginning. It is the word to find
 	Attempts         int      // Number of attempts left
-	HangmanPositions int      // Positions parsed in "hangman.txt" are stored
+	HangmanPositions int      // Attempts lost minus one (-1 at the start), the frame drawn comes from Frame
 	ListWord         []string // List of words suggested by the user
 	ListLetter       []rune   // List of letter sugested by the user
 	LastFail         bool     // Used to find out the status of the last input (used in the display).
+	IgnoreAccents    bool     // If true, a letter reveals its accented forms too (e reveals é, è and ê)
+	Rules            Rules    // Rules given when the game was created
+	Seed             int64    // Seed of the random choices of SetWord, the same seed and dictionary give the same game (0 to pick one)
+	Dictionary       string   `json:"-"` // Name of the dictionary the word comes from, stored in the save envelope
+	Mode             string   `json:"-"` // Game mode (termbox, classic, ascii, accessible, batch or json), stored in the save envelope
+	Slot             string   `json:"-"` // Save slot written by the STOP command (DefaultSlot if empty)
+	LoadedSlot       string   `json:"-"` // Save slot the game was loaded from, deleted once the game is over or saved in another slot
+	Journal          string   `json:"-"` // File written after every accepted guess, empty if the autosave is off
+	HangmanFile      string   `json:"-"` // Drawings of the hangman in HangMan_Position (DefaultHangman if empty)
+	History          string   `json:"-"` // File where the game is recorded once over (see ReadStats), empty to not record it
 }
 
-type Game struct {
-	save       bool   // True if the --startWith (-sw) argument is given
-	classic    bool   // True if the --classic (-c) argument is given
-	ascii      bool   // True if the --ascii (-a) argument is given
-	letter     bool   // True if the --letter (-l) argument is given
-	saveFile   string // Name of the file given after --startWith (-sw) where the backup is stored
-	letterFile string // Name of the file given after --letter (-l) where the ascii art is stored
-	dico       string // First argument given, contains the name of the file containing the desired dictionary
-}
-
-// Display a manual for the utilisation of argument
-func Help() {
-	filePath := "Ressources/doc.txt"
+// Kind of input given by the player
+type GuessKind int
 
-	pagerCommand := "less"
+const (
+	LetterGuess GuessKind = iota // The input is a single letter
+	WordGuess                    // The input is a whole word
+)
 
-	cmd := exec.Command(pagerCommand, filePath)
+// Status of a game
+type GameStatus int
 
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
+const (
+	InProgress GameStatus = iota // There are still letters to find and attempts left
+	Won                          // The word has been found
+	Lost                         // No more attempts
+)
 
-	err := cmd.Run()
-	if err != nil {
-		fmt.Printf("Error when executing %s: %v\n", pagerCommand, err)
-		os.Exit(1)
-	}
+// Result of a guess, it contains everything a front end needs to render it
+type GuessResult struct {
+	Input     string     // Input given by the player
+	Kind      GuessKind  // Letter or word
+	Revealed  int        // Number of positions revealed by the guess
+	Positions []int      // Index in Word of the positions revealed by the guess
+	Cost      int        // Number of attempts lost with the guess
+	Status    GameStatus // Status of the game after the guess
+	Duplicate bool       // True if the input had already been proposed
 }
 
-// Display the rule of the game
-func Rules() {
-	filePath := "Ressources/rules.txt"
-
-	pagerCommand := "less"
+type Game struct {
+	help       bool   // True if the --help (-h) argument is given
+	rules      bool   // True if the --rules (-r) argument is given
+	save       bool   // True if the --startWith (-sw) argument is given
+	resume     bool   // True if a saved game must be resumed, no new game is started (see Resume)
+	classic    bool   // True if the --classic (-c) argument is given
+	ascii      bool   // True if the --ascii (-a) argument is given
+	accessible bool   // True if the --accessible (-ac) argument is given
+	batch      bool   // True if the --batch (-b) argument is given
+	json       bool   // True if the --json (-j) argument is given
+	input      string // File given after --input (-i) where the batch and json modes read the guesses, the standard input if not given
+	letter     bool   // True if the --letterFile (-lf) argument is given
+	noAccent   bool   // True if the --ignoreAccents (-ia) argument is given
+	difficulty string // Name of the rules given after --difficulty (-d): easy, normal or hard
+	seed       int64  // Seed given after --seed (-s), 0 if not given
+	saveFile   string // Name of the slot given after --startWith (-sw) where the backup is stored
+	player     string // Name given after --player (-p), used as save slot
+	autosave   bool   // True if the --autosave (-as) argument is given
+	noRepeat   bool   // True if the --noRepeat (-nr) argument is given, the words don't come back before the end of the dictionary
+	letterFile string // Name of the file given after --letterFile (-lf) where the ascii art is stored
+	hangFile   string // Name of the file given after --hangmanFile (-hf) where the hangman drawings are stored
+	theme      string // Name or file of the theme given after --theme (-t), dark if not given
+	lang       string // Locale given after --lang (-lg), the one of the environment if not given
+	resources  string // Directory given after --resources (-rd), Ressources if not given
+	dico       string // Argument that isn't an option, contains the name of the file containing the desired dictionary
+}
 
-	cmd := exec.Command(pagerCommand, filePath)
+// Errors returned by the package, the front ends decide how to display them. Their message comes from the language pack (see SetLocale)
+var (
+	ErrInvalidInput        error = &localError{key: "error.invalidInput"}
+	ErrAlreadyGuessed      error = &localError{key: "error.alreadyGuessed"}
+	ErrGameOver            error = &localError{key: "error.gameOver"}
+	ErrInvalidArgument     error = &localError{key: "error.invalidArgument"}
+	ErrIncompatibleOptions error = &localError{key: "error.incompatibleOptions"}
+	ErrNoDictionary        error = &localError{key: "error.noDictionary"}
+	ErrUnknownRules        error = &localError{key: "error.unknownRules"}
+	ErrEmptyDictionary     error = &localError{key: "error.emptyDictionary"}
+	ErrInvalidSave         error = &localError{key: "error.invalidSave"}
+	ErrSaveTooNew          error = &localError{key: "error.saveTooNew"}
+	ErrSaveTampered        error = &localError{key: "error.saveTampered"}
+	ErrInvalidSlot         error = &localError{key: "error.invalidSlot"}
+	ErrSlotExists          error = &localError{key: "error.slotExists"}
+	ErrUnknownTheme        error = &localError{key: "error.unknownTheme"}
+	ErrUnknownLocale       error = &localError{key: "error.unknownLocale"}
+	ErrNothingToResume     error = &localError{key: "error.nothingToResume"}
+	ErrUnknownDictionary   error = &localError{key: "error.unknownDictionary"}
+	ErrGameLost            error = &localError{key: "error.gameLost"}
+	ErrUnfinished          error = &localError{key: "error.unfinished"}
+)
 
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
+// Display a manual for the utilisation of argument, generated from the options
+func Help() error {
+	var game Game
+	fmt.Println(T("cli.usage", "hangman [play] [OPTIONS] [DICTIONARY]"))
+	fmt.Println("\n" + T("help.about") + "\n\n" + T("cli.options"))
+	PrintFlags(os.Stdout, game.FlagSet("hangman"))
+	PrintSettings(os.Stdout)
+	return nil
+}
 
-	err := cmd.Run()
+// Display the rules of the game
+func DisplayRules() error {
+	content, err := fs.ReadFile(resources, "rules.txt")
 	if err != nil {
-		fmt.Printf("Error when executing %s: %v\n", pagerCommand, err)
-		os.Exit(1)
+		return err
 	}
+	_, err = os.Stdout.Write(content)
+	return err
 }
 
-// Set HangManData's first value
-func (hangman *HangManData) SetData() {
+// Set HangManData's first value with the rules of the game
+func (hangman *HangManData) SetData(rules Rules) {
+	hangman.Rules = rules
 	hangman.Word = []rune{}
 	hangman.ToFind = ""
-	hangman.Attempts = 10
+	hangman.Seed = 0
+	hangman.Attempts = rules.Attempts
 	hangman.HangmanPositions = -1
 	hangman.ListLetter = []rune{}
 	hangman.ListWord = []string{}
 }
 
-// Set Word and ToFind for HangManData
-func (hang *HangManData) SetWord(dico []string) {
-	// Find a random word
-	randomIndex := rand.Intn(len(dico) - 1)
-	hang.ToFind = dico[randomIndex]
-
-	nbVisibleLetter := len(hang.ToFind)/2 - 1 // Set the number of letters that will be visible
-
-	for range hang.ToFind { // Set Word
-		hang.Word = append(hang.Word, '_')
+// Set Word and ToFind for HangManData with a word of the dictionary, the random choices are made from Seed
+func (hang *HangManData) SetWord(dico []string) error {
+	if hang.Seed == 0 { // Choose a seed and keep it, so that the game can be replayed
+		hang.Seed = NewSeed()
+	}
+	picker, err := NewWordPicker(dico, hang.Seed, false) // Every word of the dictionary can be chosen
+	if err != nil {
+		return err
 	}
+	hang.SetWordFrom(picker)
+	return nil
+}
 
-	again := false
-	var place []int
+// Set Word and ToFind for HangManData with the given word, the revealed letters are chosen from Seed
+func (hang *HangManData) SetToFind(word string) {
+	if hang.Seed == 0 {
+		hang.Seed = NewSeed()
+	}
+	random := rand.New(rand.NewSource(hang.Seed))
 
-	for nbVisibleLetter > 0 { // Reveal random letters in the word to find
-		randomIndex = rand.Intn(len(hang.ToFind))
-		for _, j := range place {
-			if j == randomIndex {
-				again = true
-			}
+	hang.ToFind = word
+	WordRune := []rune(hang.ToFind)
+	hang.Word = []rune{}
+	var letters []rune               // Letters to find, each one once: revealing a letter reveals all its positions
+	for _, runes := range WordRune { // Set Word, spaces and punctuation are always shown
+		if !IsGuessable(runes) {
+			hang.Word = append(hang.Word, runes)
+			continue
 		}
-		if !again {
-			place = append(place, randomIndex)
-			nbVisibleLetter--
-		} else {
-			again = false
+		hang.Word = append(hang.Word, '_')
+		if !slices.ContainsFunc(letters, func(letter rune) bool { return hang.SameLetter(letter, runes) }) {
+			letters = append(letters, runes)
 		}
 	}
 
-	WordRune := []rune(hang.ToFind)
-	for _, index := range place { // Add the different letter into Word
-		hang.Word[index] = WordRune[index]
-		hang.LetterInWord(WordRune[index])
-		if !hang.UsedVerif(string(WordRune[index])) {
-			hang.UsedLetter(WordRune[index])
+	nbVisibleLetter := hang.Rules.RevealCount(len(letters))         // Set the number of letters that will be visible, one is always left
+	for _, i := range random.Perm(len(letters))[:nbVisibleLetter] { // Reveal random letters in the word to find
+		hang.LetterInWord(letters[i])
+		if hang.Rules.RevealedUsed {
+			hang.UsedLetter(letters[i])
 		}
 	}
 }
 
-// Function to check whether the given letter is in ToFind
-func (game *HangManData) LetterInWord(oneRune rune) {
+// Return true if the rune is a letter (or a digit) the player has to find.
+// Spaces, hyphens, apostrophes and other punctuation are always shown.
+func IsGuessable(oneRune rune) bool {
+	return unicode.IsLetter(oneRune) || unicode.IsDigit(oneRune)
+}
+
+// Return a new random seed, never 0
+func NewSeed() int64 {
+	seed := rand.Int63()
+	for seed == 0 {
+		seed = rand.Int63()
+	}
+	return seed
+}
+
+// Function to check whether the given letter is in ToFind, return the index(es) where it was found
+func (game *HangManData) LetterInWord(oneRune rune) []int {
 	var place []int
-	for index, letters := range game.ToFind { // Transforms letters into lower case if they are not already lower case
-		if oneRune >= 'A' && oneRune <= 'Z' {
-			oneRune = oneRune + 32
-		}
-		if letters == oneRune || letters == oneRune-32 {
+	toFindRune := []rune(game.ToFind)
+	for index, letters := range toFindRune { // Compare the letters without case (and without accent if asked)
+		if game.SameLetter(letters, oneRune) {
 			place = append(place, index) // Saves the index(es) of the position where the letter was found
 		}
 	}
-	if len(place) != 0 { // If any letters have been found then replace the corresponding slots with the letters
+	if len(place) != 0 { // If any letters have been found then replace the corresponding slots with the letters of ToFind
 		game.LastFail = false
 		for _, index := range place {
-			game.Word[index] = oneRune
+			game.Word[index] = toFindRune[index]
 		}
-	} else { // If the letter is not found, an attempt is lost
-		game.LastFail = true
-		game.Attempts--
-		game.HangmanPositions++
+	} else { // If the letter is not found, attempts are lost
+		game.loseAttempts(game.Rules.LetterCost)
 	}
+	return place
 }
 
-// Function to check whether the given word is ToFind (return true if this is the case)
-func (game *HangManData) IsThisTheWord(word string) bool {
-	var oneRune rune
-	if len(word) != len(game.ToFind) { // Check that words are the same size
-		return false
-	} else {
-		wordRune := []rune(word)
-		toFindRune := []rune(game.ToFind)
-		for index, runes := range wordRune {
-			oneRune = runes
-			if runes >= 'A' && runes <= 'Z' { // Puts letters in lower case for easier comparison
-				oneRune = oneRune + 32
-			}
-			if oneRune != toFindRune[index] && oneRune != toFindRune[index]+32 {
-				return false
-			}
-		}
+// Removes attempts after a wrong input, the hangman goes forward as many positions
+func (game *HangManData) loseAttempts(cost int) {
+	game.LastFail = true
+	game.Attempts -= cost
+	game.HangmanPositions += cost
+	if game.Attempts < 0 {
+		game.Attempts = 0
 	}
-	return true
+}
+
+// Function to check whether the given word is ToFind (return true if this is the case).
+// Only the letters are compared, "arc en ciel" and "arcenciel" are both "arc-en-ciel".
+func (game *HangManData) IsThisTheWord(word string) bool {
+	return game.foldWord(word) == game.foldWord(game.ToFind)
 }
 
 // Adds the rune passed as a parameter to ListLetter if it's not already there
 func (game *HangManData) UsedLetter(oneRune rune) {
-	if oneRune < 'A' || oneRune > 'Z' { // Make the letter uppercase if it isn't already
-		oneRune = oneRune - 32
-	}
-	if oneRune >= 'A' && oneRune <= 'Z' { // Add the letter into ListLetter (only if it's a letter)
-		game.ListLetter = append(game.ListLetter, oneRune)
-	}
+	game.ListLetter = append(game.ListLetter, unicode.ToUpper(oneRune)) // Letters are stored in upper case
 }
 
-// Adds the rune passed as a parameter to ListLetter if it's not already there
+// Adds the word passed as a parameter to ListWord if it's not already there
 func (game *HangManData) UsedWord(word string) {
-	runeWord := []rune(word)
-	for index, runes := range runeWord { // Transforms letters into lower case if they are not already lower case
-		if runes < 'a' || runes > 'z' {
-			runeWord[index] = runes + 32
-		}
-	}
-	game.ListWord = append(game.ListWord, string(runeWord)) // Add the word into ListLetter
+	game.ListWord = append(game.ListWord, strings.ToLower(word)) // Words are stored in lower case
 }
 
 // Checks if the input is already in one of the ListWord or ListLetter (return true if it's the case)
 func (game *HangManData) UsedVerif(intput string) bool {
-	runeWord := []rune(intput)
-	oneRune := runeWord[0]
 	if utf8.RuneCountInString(intput) > 1 {
-		for index, runes := range runeWord { // Transforms letters into lower case if they are not already lower case
-			if runes < 'a' || runes > 'z' {
-				runeWord[index] = runes + 32
-			}
-		}
 		for _, words := range game.ListWord { // Search the word into ListWord
-			if string(runeWord) == words {
+			if game.foldWord(words) == game.foldWord(intput) {
 				return true
 			}
 		}
 	} else {
-		if oneRune < 'A' || oneRune > 'Z' { // Make the letter uppercase if it isn't already
-			oneRune = oneRune - 32
-		}
+		oneRune, _ := utf8.DecodeRuneInString(intput)
 		for _, letter := range game.ListLetter { // Search the letter into ListLetter
-			if letter == oneRune {
+			if game.SameLetter(letter, oneRune) {
 				return true
 			}
 		}
@@ -212,38 +272,35 @@ func (game *HangManData) UsedVerif(intput string) bool {
 	return false
 }
 
-// Saves the party's progress, which is stored in the HangManData structure
-func (data HangManData) Save(filename string) error {
-	file, err := os.Create(filename) // Create a file
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	encoder := json.NewEncoder(file)
-	if err := encoder.Encode(data); err != nil { // Save HangManData
-		return err
-	}
-
-	return nil
+// Return true if the letter is already shown in Word, a revealed letter that is not in ListLetter can't be proposed either
+func (game *HangManData) isShown(oneRune rune) bool {
+	return slices.ContainsFunc(game.Word, func(shown rune) bool {
+		return shown != '_' && IsGuessable(shown) && game.SameLetter(shown, oneRune)
+	})
 }
 
-// Load the party's progress, which is stored in filename, return a HangManData struct
-func Load(filename string) (HangManData, error) {
-	var data HangManData
-
-	file, err := os.Open(filename) // Open filename
-	if err != nil {
-		return data, err
-	}
-	defer file.Close()
+// Return true if both runes are the same letter, without case (and without accent if IgnoreAccents is set)
+func (game *HangManData) SameLetter(a, b rune) bool {
+	return game.foldRune(a) == game.foldRune(b)
+}
 
-	decoder := json.NewDecoder(file)
-	if err := decoder.Decode(&data); err != nil { // Load HangManData
-		return data, err
+// Return the rune in a form that can be compared: lower case, and without accent if IgnoreAccents is set
+func (game *HangManData) foldRune(oneRune rune) rune {
+	oneRune = unicode.ToLower(unicode.ToUpper(oneRune)) // Goes through upper case so that letters like 'ſ' fold too
+	if game.IgnoreAccents {
+		oneRune = RemoveAccent(oneRune)
 	}
+	return oneRune
+}
 
-	return data, nil
+// Return the letters of the word in a form that can be compared (see foldRune), the other characters are dropped
+func (game *HangManData) foldWord(word string) string {
+	return strings.Map(func(oneRune rune) rune {
+		if !IsGuessable(oneRune) {
+			return -1
+		}
+		return game.foldRune(oneRune)
+	}, word)
 }
 
 // Draw a box in x, y with size width/height, color borderColor with title in terminal
@@ -271,298 +328,390 @@ func DrawBox(x, y, width, height int, borderColor termbox.Attribute, title strin
 }
 
 // Main display, efficient for all boxes and hangman
-func (hang *HangManData) display() {
+func (hang *HangManData) display(layout Layout, theme Theme) error {
 	// Main box
-	DrawBox(0, 0, 100, 24, termbox.ColorWhite, "main")
+	DrawBox(layout.Main.X, layout.Main.Y, layout.Main.Width, layout.Main.Height, theme.Main, T("box.main"))
 
 	// First box inside the main box
-	DrawBox(55, 0, 45, 15, termbox.ColorLightYellow, "Hangman")
+	box := layout.Hangman
+	DrawBox(box.X, box.Y, box.Width, box.Height, theme.Hangman, T("box.hangman"))
 	// HangMan in the first box
-	if hang.HangmanPositions >= 0 && hang.HangmanPositions <= 9 {
-		hang.DisplayHangman(55+18, 4, termbox.ColorBlue)
+	art, err := hang.art()
+	if err != nil {
+		return err
+	}
+	if err := hang.DisplayHangman(box.X+(box.Width-art.Width())/2, max(box.Y+1, box.Y+box.Height-4-art.Height), theme.Drawing); err != nil { // On the floor of the box
+		return err
 	}
 
 	// Second box inside the main box
-	DrawBox(0, 0, 50, 8, termbox.ColorBlue, "Word...")
-	DrawBox(25, 0, 25, 8, termbox.ColorBlue, "Attempts")
+	DrawBox(layout.Word.X, layout.Word.Y, layout.Word.Width, layout.Word.Height, theme.Word, T("box.word"))
+	DrawBox(layout.Attempts.X, layout.Attempts.Y, layout.Attempts.Width, layout.Attempts.Height, theme.Attempts, T("box.attempts"))
 
 	// Third box inside the main box
-	DrawBox(0, 8, 50, 8, termbox.ColorGreen, "Letter")
+	DrawBox(layout.Letter.X, layout.Letter.Y, layout.Letter.Width, layout.Letter.Height, theme.Letter, T("box.letter"))
 
 	// Fourth box inside the main box
-	DrawBox(0, 16, 50, 8, termbox.ColorLightMagenta, "Used letter/words")
+	DrawBox(layout.Used.X, layout.Used.Y, layout.Used.Width, layout.Used.Height, theme.Used, T("box.used"))
+	return nil
 }
 
 // drawText is a function that draws text
 // It takes a slice of runes (text), x and y coordinates, a color attribute, and a cursor flag.
+// The text goes to the next line every 46 runes, 5 lines at most.
 func DrawText(text []rune, x, y int, color termbox.Attribute, cursor bool) {
-	// Initialize variables to track line and space offsets
-	ligne := 0
-	space := 0
+	drawInput(text, Rect{x, y, 46, 5}, color, cursor)
+}
 
-	// Loop through the text and process each character
-	for i, ch := range text {
-		// Determine the line and space offsets based on the character index
-		switch {
-		case i > 45 && i <= 91:
-			space = 46
-			ligne = 1
-		case i > 91 && i <= 137:
-			space = 92
-			ligne = 2
-		case i > 137 && i <= 183:
-			space = 138
-			ligne = 3
-		case i > 183 && i <= 229:
-			space = 184
-			ligne = 4
-		default:
-			ligne = 0
-			space = 0
-		}
-
-		// Set the character at the specified position with the given color
-		termbox.SetCell(x+i-space, y+ligne, ch, termbox.ColorDefault, termbox.ColorDefault)
-	}
-
-	// If the cursor flag is true, draw a cursor character at a specific position
+// Draws the text in the area, cut every area.Width runes, with a cursor after it if cursor is true
+func drawInput(text []rune, area Rect, color termbox.Attribute, cursor bool) {
+	if area.Width <= 0 {
+		return
+	}
 	if cursor {
-		termbox.SetCell(2+len(text)-space, 10+ligne, '_', termbox.ColorDefault, termbox.ColorDefault)
+		text = append(text[:len(text):len(text)], '_')
 	}
+	var lines []string
+	for len(text) > area.Width {
+		lines = append(lines, string(text[:area.Width]))
+		text = text[area.Width:]
+	}
+	drawLines(append(lines, string(text)), area, color)
 }
 
 // Display HangMan on the right position
-func (hang *HangManData) DisplayHangman(x, y int, borderColor termbox.Attribute) {
-	hangMan := ReadHang("Ressources/HangMan_Position/hangman.txt")
-	for i := 0; i <= 7; i++ { // Display ligne by ligne
-		runes := []rune(hangMan[hang.HangmanPositions][i])
+func (hang *HangManData) DisplayHangman(x, y int, borderColor termbox.Attribute) error {
+	art, err := hang.art()
+	if err != nil {
+		return err
+	}
+	frame := hang.Frame(len(art.Frames))
+	if frame < 0 { // Nothing to draw before the first attempt lost
+		return nil
+	}
+	for i, line := range art.Frames[frame] { // Display ligne by ligne
+		runes := []rune(line)
 		for index, j := range runes { // Display rune by rune
 			termbox.SetCell(x+index, y+i, j, borderColor, termbox.ColorDefault)
 		}
 	}
+	return nil
+}
+
+// Renderer and input source of the termbox mode, the input is typed in the "Letter" box
+type termboxRenderer struct {
+	game     Game         // Options of the game, for the ascii art font
+	theme    Theme        // Colors of the boxes and texts
+	hang     *HangManData // Game drawn at each key
+	last     string       // Last input given, drawn in the ascii box while the game is in progress
+	input    string       // Input being typed
+	rejected bool         // True after a refused input, a message is shown in the input box until the next key
+	message  string       // Printed once the terminal is given back
 }
 
 // TermBoxGame is a function that handles the main game loop for a Hangman game using the termbox library.
 // It takes the HangManData and Game structs as input parameters.
-func (HangMan HangManData) TermBoxGame(game Game) {
+func (HangMan HangManData) TermBoxGame(game Game) error {
+	theme, err := ThemeFor(game.theme)
+	if err != nil {
+		return err
+	}
+
 	// Initialize the termbox library and handle errors
 	if err := termbox.Init(); err != nil {
-		panic(err)
+		return err
+	}
+	if theme.Output256 {
+		termbox.SetOutputMode(termbox.Output256)
 	}
-	defer termbox.Close()
+	stopWatch := DefaultAssets.Watch(time.Second) // Edited fonts and drawings are shown at the next key
+	defer stopWatch()
+	renderer := &termboxRenderer{game: game, theme: theme, last: "/"}
+	defer func() {
+		termbox.Close()
+		if renderer.message != "" {
+			fmt.Println(renderer.message)
+		}
+	}()
+
+	return HangMan.PlayTerminal(renderer, renderer)
+}
 
-	// Initialize variable
-	word := "/"
-	userInput := ""
-	gameOver := false
-	empty := "Empty or already proposed!"
+func (t *termboxRenderer) Start(hang *HangManData) error {
+	t.hang = hang
+	return t.draw()
+}
 
-	for {
-		// Clear the screen and set up user interface
-		termbox.Clear(termbox.ColorDefault, termbox.ColorDefault)
-		game.AsciiBox(word)
-		game.AsciiCounter(HangMan.Attempts)
+func (t *termboxRenderer) Guess(hang *HangManData, result GuessResult) error {
+	t.last, t.input = result.Input, "" // Clear user input
+	return t.draw()
+}
 
-		HangMan.display()
+func (t *termboxRenderer) Reject(hang *HangManData, input string, err error) error {
+	t.input, t.rejected = "", true
+	return t.draw()
+}
 
-		// Display text
-		DrawText([]rune(userInput), 2, 10, termbox.ColorDefault, true)
-		DrawText(HangMan.Word, 2, 4, termbox.ColorDefault, false)
-		DrawText(HangMan.ListLetter, 2, 17, termbox.ColorDefault, false)
-		for i := range HangMan.ListWord {
-			DrawText([]rune(HangMan.ListWord[i]), 2, 18+i, termbox.ColorDefault, false)
+func (t *termboxRenderer) Message(text string) {
+	t.message = text
+}
+
+// Shows the result until the player leaves with QUIT or Esc
+func (t *termboxRenderer) End(hang *HangManData) error {
+	for {
+		input, err := t.Next()
+		if err != nil || input == "QUIT" {
+			return err
+		}
+	}
+}
+
+// Edits the input with the keys until it is validated with space or enter, Esc gives QUIT
+func (t *termboxRenderer) Next() (string, error) {
+	for {
+		if err := t.draw(); err != nil {
+			return "", err
 		}
-		termbox.Flush()
 
 		// Poll for user input events
 		ev := termbox.PollEvent()
-		if ev.Type == termbox.EventKey {
-			if ev.Key == termbox.KeyEsc {
-				return // Exit the game loop
-			} else if ev.Key == termbox.KeySpace || ev.Key == termbox.KeyEnter {
-				if !gameOver {
-					if userInput != "" && !HangMan.UsedVerif(userInput) && userInput != empty {
-						// Check if the user's input is a valid guess and update the word or game status
-						if HangMan.MainMecanics(userInput) {
-							word = "win"
-							gameOver = true
-						} else {
-							word = userInput
-						}
-						userInput = "" // Clear user input
-					} else {
-						userInput = empty
-					}
-				} else {
-					if userInput == "QUIT" {
-						return
-					}
-				}
-			} else if ev.Key == termbox.KeyDelete {
-				userInput = "" // Clear user input
-			} else if ev.Key == termbox.KeyBackspace || ev.Key == termbox.KeyBackspace2 {
-				if userInput != "" && userInput != empty {
-					userInput = userInput[:len(userInput)-1] // Remove the last character from user input
-				}
-			} else {
-				if userInput == empty {
-					userInput = ""
-				}
-				userInput += string(ev.Ch) // Add the character to user input
+		if ev.Type == termbox.EventError {
+			return "", ev.Err
+		}
+		if ev.Type != termbox.EventKey { // A resize is drawn with the new layout
+			continue
+		}
+		switch {
+		case ev.Key == termbox.KeyEsc:
+			return "QUIT", nil // Exit the game loop
+		case ev.Key == termbox.KeySpace || ev.Key == termbox.KeyEnter:
+			return t.input, nil
+		case ev.Key == termbox.KeyDelete:
+			t.input, t.rejected = "", false // Clear user input
+		case ev.Key == termbox.KeyBackspace || ev.Key == termbox.KeyBackspace2:
+			if t.input != "" {
+				runes := []rune(t.input)
+				t.input = string(runes[:len(runes)-1]) // Remove the last character from user input
 			}
+		case ev.Ch != 0:
+			t.rejected = false
+			t.input += string(ev.Ch) // Add the character to user input
 		}
+	}
+}
 
-		// Check if the game has ended
-		if HangMan.EndGame() {
-			if HangMan.Attempts <= 0 {
-				word = "lose"
-				HangMan.Word = []rune(HangMan.ToFind)
-			} else {
-				word = "win"
-			}
-			gameOver = true
+// Clear the screen and draw the whole game, the boxes are computed from the size of the terminal
+func (t *termboxRenderer) draw() error {
+	termbox.Clear(termbox.ColorDefault, termbox.ColorDefault)
+	layout := TerminalLayout()
+	word := t.hang.Word
+	if t.hang.Status() == Lost { // The word is given once the game is lost
+		word = []rune(t.hang.ToFind)
+	}
+	if layout.Compact {
+		if err := t.drawCompact(layout, word); err != nil {
+			return err
 		}
+		return termbox.Flush()
 	}
+
+	if err := t.game.asciiBox(t.last, t.hang.Status(), layout.Ascii, t.theme); err != nil {
+		return err
+	}
+	if err := t.game.asciiCounter(t.hang.Attempts, layout.Attempts, t.theme.Counter); err != nil {
+		return err
+	}
+	if err := t.hang.display(layout, t.theme); err != nil {
+		return err
+	}
+
+	// Display text
+	wordArea := layout.Word.inside(1)
+	wordArea.Y, wordArea.Height = wordArea.Y+3, wordArea.Height-3 // Under the title
+	drawInput(word, wordArea, t.theme.Text, false)
+	letterArea := layout.Letter.inside(1)
+	letterArea.Y, letterArea.Height = letterArea.Y+1, letterArea.Height-1
+	drawInput([]rune(t.shownInput()), letterArea, t.theme.Text, true)
+	drawLines(t.usedLines(layout.Used.inside(1).Width), layout.Used.inside(1), t.theme.Text)
+	return termbox.Flush()
 }
 
-// This is the hangman Ascii game
-func (game HangManData) AsciiGame(data Game) {
-	var inputs string
-	gameOver := false
-	fmt.Printf("Good Luck, you have %d attempts.\n", game.Attempts)
-	data.DisplayAsciiText(game.Word)
-
-	for !gameOver { // Game loop
-		// Display input
-		letter := Input("\nChoose : ", inputs)
-
-		// Verify input
-		if letter != "" && !game.UsedVerif(letter) {
-			if game.MainMecanics(letter) {
-				gameOver = true
-			}
+// Draws the game as lines of text in a terminal too small for the boxes, the hangman is drawn under them if there is room
+func (t *termboxRenderer) drawCompact(layout Layout, word []rune) error {
+	DrawBox(layout.Main.X, layout.Main.Y, layout.Main.Width, layout.Main.Height, t.theme.Main, T("box.compact"))
+	area := layout.Main.inside(0)
+	status := T("compact.attempts", t.hang.Attempts)
+	switch t.hang.Status() {
+	case Won:
+		status += T("compact.win")
+	case Lost:
+		status += T("compact.lose")
+	}
 
-			if game.LastFail {
-				fmt.Printf("Not present in the word, %d attempts remaining\n", game.Attempts)
-			}
+	lines := wrapText(status, area.Width)
+	lines = append(lines, wrapText(string(word), area.Width)...)
+	lines = append(lines, wrapText("> "+t.shownInput()+"_", area.Width)...)
+	lines = append(lines, t.usedLines(area.Width)...)
+	used := drawLines(lines, area, t.theme.Text)
 
-			// Display AsciiText and HangMan
-			data.DisplayAsciiText(game.Word)
-			if game.HangmanPositions >= 0 {
-				game.DisplayHangmanClassic()
-			}
+	art, err := t.hang.art()
+	if err != nil {
+		return err
+	}
+	if area.Height-used > art.Height && area.Width >= art.Width() { // Room left for the hangman
+		return t.hang.DisplayHangman(area.X, area.Y+used+1, t.theme.Drawing)
+	}
+	return nil
+}
 
-		} else {
-			fmt.Println("Empty or already proposed!")
-		}
+// Return the text of the input box: the input, or the message of a refused input
+func (t *termboxRenderer) shownInput() string {
+	if t.rejected {
+		return T("input.rejected")
+	}
+	return t.input
+}
 
-		// Verify if it's the end of the game
-		if game.EndGame() {
-			gameOver = true
-		}
+// Return the used letters, then the used words, cut in lines of width runes
+func (t *termboxRenderer) usedLines(width int) []string {
+	lines := wrapText(string(t.hang.ListLetter), width)
+	if len(t.hang.ListWord) != 0 {
+		lines = append(lines, wrapText(strings.Join(t.hang.ListWord, " "), width)...)
 	}
+	return lines
+}
 
-	// Announcement of results
-	if game.Attempts > 0 {
-		fmt.Println("Congrats !")
-	} else {
-		fmt.Println("The word was " + game.ToFind + ". You'll do better next time!!!")
+// Directory of the fonts in the resources
+const fontDir = "Ascii_Letter"
+
+// Return the font chosen with letterFile, standard.txt by default
+func (data *Game) font() (*Font, error) {
+	if data.letterFile == "" {
+		return DefaultAssets.Font(fontDir + "/standard.txt")
 	}
+	return DefaultAssets.Font(fontDir + "/" + data.letterFile)
 }
 
 // Displays a given ascii character in x y
-func (data *Game) DisplayAscii(x, y, version int, borderColor termbox.Attribute) {
-	var ascii [95][9]string
-	switch data.letterFile { //choose the right font for ascii art
-	case "shadow.txt":
-		ascii = ReadAscii("Ressources/Ascii_Letter/shadow.txt")
-	case "standard.txt":
-		ascii = ReadAscii("Ressources/Ascii_Letter/standard.txt")
-	case "thinkertoy.txt":
-		ascii = ReadAscii("Ressources/Ascii_Letter/thinkertoy.txt")
-	default:
-		ascii = ReadAscii("Ressources/Ascii_Letter/standard.txt")
-	}
-	for i := 0; i <= 8; i++ { //displays the correct character
-		runes := []rune(ascii[version-32][i])
-		for index, j := range runes {
+func (data *Game) DisplayAscii(x, y, version int, borderColor termbox.Attribute) error {
+	font, err := data.font()
+	if err != nil {
+		return err
+	}
+	drawAscii(font, x, y, rune(version), borderColor)
+	return nil
+}
+
+// Displays a character of the font in x y
+func drawAscii(font *Font, x, y int, char rune, borderColor termbox.Attribute) {
+	for i, line := range font.Glyph(char) { //displays the correct character
+		for index, j := range []rune(line) {
 			termbox.SetCell(x+index, y+i, j, borderColor, termbox.ColorDefault)
 		}
 	}
 }
 
+// Displays a text of the font in x y, character after character
+func drawAsciiText(font *Font, x, y int, text string, borderColor termbox.Attribute) {
+	for i, line := range font.Render(text) {
+		for index, j := range []rune(line) {
+			termbox.SetCell(x+index, y+i, j, borderColor, termbox.ColorDefault)
+		}
+	}
+}
+
+// Return a rune that can be drawn with the ascii fonts: the accent is removed and the other runes become '?'
+func AsciiRune(oneRune rune) rune {
+	oneRune = RemoveAccent(oneRune)
+	if oneRune < ' ' || oneRune > '~' {
+		return '?'
+	}
+	return oneRune
+}
+
 // Displays the last letter entered by a user in the terminal, followed by the final result (win or lose).
-func (data *Game) AsciiBox(word string) {
+// The words "win" and "lose" give the result, as in the first version.
+func (data *Game) AsciiBox(word string) error {
+	status := InProgress
 	switch word {
-	case "win": //display WIN if player win
-		data.DisplayAscii(55+19, 15, 'I', termbox.ColorGreen)
-		data.DisplayAscii(59, 15, 'W', termbox.ColorGreen)
-		data.DisplayAscii(55+16+11, 15, 'N', termbox.ColorGreen)
-	case "lose": //display lose if player lose
-		data.DisplayAscii(56, 15, 'L', termbox.ColorRed)
-		data.DisplayAscii(55+11, 15, 'O', termbox.ColorRed)
-		data.DisplayAscii(55+16+5, 15, 'S', termbox.ColorRed)
-		data.DisplayAscii(55+16+14, 15, 'E', termbox.ColorRed)
+	case "win":
+		status = Won
+	case "lose":
+		status = Lost
+	}
+	return data.asciiBox(word, status, NewLayout(100, 24).Ascii, DarkTheme)
+}
+
+// Displays the last input while the game is in progress, then the result, centered in the area
+func (data *Game) asciiBox(word string, status GameStatus, area Rect, theme Theme) error {
+	font, err := data.font()
+	if err != nil {
+		return err
+	}
+	switch status {
+	case Won: //display WIN if player win
+		drawAsciiText(font, area.X+(area.Width-font.Width(T("ascii.win")))/2, area.Y+1, T("ascii.win"), theme.Win)
+	case Lost: //display lose if player lose
+		drawAsciiText(font, area.X+(area.Width-font.Width(T("ascii.lose")))/2, area.Y+1, T("ascii.lose"), theme.Lose)
 	default: //displays the first rune of the last input
-		runes := []rune(word)
-		if int(runes[0]) > 33 && int(runes[0]) < 126 {
-			data.DisplayAscii(55+16, 15, int(runes[0]), termbox.ColorLightRed)
-		} else {
-			data.DisplayAscii(55+16, 15, '/', termbox.ColorLightRed)
+		char := '/'
+		if runes := []rune(word); len(runes) != 0 && unicode.IsGraphic(runes[0]) && !unicode.IsSpace(runes[0]) {
+			char = runes[0]
 		}
+		drawAscii(font, area.X+(area.Width-font.Width(string(char)))/2, area.Y+1, char, theme.LastInput)
 	}
+	return nil
 }
 
 // DisplayAsciiText displays ASCII art text using the 'letterFile' font.
 // The function selects the font based on 'letterFile' and displays the ASCII art text.
-func (data *Game) DisplayAsciiText(words []rune) {
-	// Define a 2D array 'ascii' to hold ASCII art characters.
-	var ascii [95][9]string
-
+func (data *Game) DisplayAsciiText(words []rune) error {
 	// Select the font for the ASCII art based on the 'letterFile' field.
-	switch data.letterFile {
-	case "shadow.txt":
-		ascii = ReadAscii("Ressources/Ascii_Letter/shadow.txt")
-	case "standard.txt":
-		ascii = ReadAscii("Ressources/Ascii_Letter/standard.txt")
-	case "thinkertoy.txt":
-		ascii = ReadAscii("Ressources/Ascii_Letter/thinkertoy.txt")
-	default:
-		ascii = ReadAscii("Ressources/Ascii_Letter/standard.txt")
+	font, err := data.font()
+	if err != nil {
+		return err
 	}
 
-	// Loop through each line of the ASCII art (9 lines in total).
-	for line := 0; line <= 8; line++ {
-		// Loop through each letter (rune) in the 'words' slice.
-		for _, letter := range words {
-			// Print the ASCII character for the current letter on the current line.
-			// The ASCII value of the letter is used to index 'ascii' array.
-			fmt.Printf(ascii[letter-32][line])
-		}
-		// After printing a line of text, move to the next line.
-		fmt.Println("")
+	// An empty line, then each line of the ASCII art.
+	fmt.Println("")
+	for _, line := range font.Render(string(words)) {
+		fmt.Println(line)
 	}
+	return nil
+}
+
+// Displays the number of attempts left in ascii art
+func (data Game) AsciiCounter(attempts int) error {
+	return data.asciiCounter(attempts, NewLayout(100, 24).Attempts, DarkTheme.Counter)
 }
 
-func (data Game) AsciiCounter(attempts int) {
-	switch attempts {
-	case 1:
-		data.DisplayAscii(35, -1, '1', termbox.ColorLightGray)
-	case 10:
-		data.DisplayAscii(33, -1, '1', termbox.ColorLightGray)
-		data.DisplayAscii(36, -1, '0', termbox.ColorLightGray)
-	default:
-		data.DisplayAscii(34, -1, attempts+'0', termbox.ColorLightGray)
+// Displays the number of attempts left centered in the box
+func (data Game) asciiCounter(attempts int, box Rect, color termbox.Attribute) error {
+	font, err := data.font()
+	if err != nil {
+		return err
 	}
+	counter := strconv.Itoa(attempts)
+	drawAsciiText(font, box.X+(box.Width-font.Width(counter))/2, box.Y+1, counter, color)
+	return nil
 }
 
 // Displays the hangman in the terminal
-func (hang HangManData) DisplayHangmanClassic() {
-	hangMan := ReadHang("Ressources/HangMan_Position/hangman.txt")
+func (hang HangManData) DisplayHangmanClassic() error {
+	art, err := hang.art()
+	if err != nil {
+		return err
+	}
+	frame := hang.Frame(len(art.Frames))
+	if frame < 0 { // Nothing to draw before the first attempt lost
+		return nil
+	}
 	fmt.Println("")
-	for i := 0; i <= 7; i++ {
-		fmt.Println(hangMan[hang.HangmanPositions][i])
+	for _, line := range art.Frames[frame] {
+		fmt.Println(line)
 	}
+	fmt.Println("")
+	return nil
 }
 
 // displays the rune array given as a parameter in the terminal
@@ -574,254 +723,299 @@ func PrintRune(tab []rune) {
 	fmt.Println("")
 }
 
-// return user input
+// Reader of the standard input shared by the prompts, so that the text typed in advance isn't lost between two of them
+var stdin = bufio.NewReader(os.Stdin)
+
+// Writes the prompt and returns the line typed by the player, without its end of line.
+// io.EOF is returned once the input is over.
+func ReadLine(prompt string) (string, error) {
+	fmt.Print(prompt)
+	line, err := stdin.ReadString('\n')
+	if err != nil && (line == "" || !errors.Is(err, io.EOF)) { // The last line can have no end of line
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// return user input, empty once the input is over (see ReadLine)
 func Input(s string, inputs string) string {
-	fmt.Print(s)
-	fmt.Scanln(&inputs)
+	if line, err := ReadLine(s); err == nil {
+		inputs = line
+	}
 	return inputs
 }
 
+// Writes the message and waits for the player to press enter. The batch and json modes never wait: err is returned instead.
+func (game Game) confirm(message string, err error) error {
+	if game.scripted() {
+		return err
+	}
+	fmt.Println(message)
+	_, err = ReadLine("")
+	return err
+}
+
+// Renderer of the classic and ascii modes, everything is printed one after the other
+type classicRenderer struct {
+	showWord func(word []rune) error // Prints the word, letter by letter or in ascii art
+}
+
+// Input source of the classic and ascii modes, each input is a line of the terminal
+type promptInput struct{}
+
+func (promptInput) Next() (string, error) {
+	return ReadLine(T("classic.prompt"))
+}
+
+// This is the hangman Ascii game
+func (game HangManData) AsciiGame(data Game) error {
+	return game.PlayTerminal(&classicRenderer{showWord: data.DisplayAsciiText}, promptInput{})
+}
+
 // This is the hangman classic game
-func (game HangManData) ClassicGame() {
-	var inputs string
-	gameOver := false
-	fmt.Printf("Good Luck, you have %d attempts.\n", game.Attempts)
-	PrintRune(game.Word)
-
-	for !gameOver { // Game loop
-		// Display word and attempts
-		letter := Input("\nChoose : ", inputs)
-
-		// Verify input
-		if letter != "" && !game.UsedVerif(letter) {
-			if game.MainMecanics(letter) {
-				gameOver = true
-			}
+func (game HangManData) ClassicGame() error {
+	printWord := func(word []rune) error {
+		PrintRune(word)
+		return nil
+	}
+	return game.PlayTerminal(&classicRenderer{showWord: printWord}, promptInput{})
+}
 
-			if game.LastFail {
-				fmt.Printf("Not present in the word, %d attempts remaining\n", game.Attempts)
-			}
+func (c *classicRenderer) Start(hang *HangManData) error {
+	fmt.Println(T("classic.start", hang.Attempts))
+	return c.showWord(hang.Word)
+}
 
-			// Display words and HangMan
-			PrintRune(game.Word)
-			if game.HangmanPositions >= 0 {
-				game.DisplayHangmanClassic()
-			}
+func (c *classicRenderer) Guess(hang *HangManData, result GuessResult) error {
+	if result.Cost > 0 {
+		fmt.Println(T("classic.miss", hang.Attempts))
+	}
 
-			// Verify if it's the end of the game
-			if game.EndGame() {
-				gameOver = true
-			}
-		} else {
-			fmt.Println("Empty or already proposed!")
-		}
+	// Display word and HangMan
+	if err := c.showWord(hang.Word); err != nil {
+		return err
 	}
+	return hang.DisplayHangmanClassic()
+}
+
+func (c *classicRenderer) Reject(hang *HangManData, input string, err error) error {
+	fmt.Println(T("input.rejected"))
+	return nil
+}
+
+func (c *classicRenderer) Message(text string) {
+	fmt.Println(text)
+}
 
-	// Announcement of results
-	if game.Attempts > 0 {
-		fmt.Println("Congrats !")
+func (c *classicRenderer) End(hang *HangManData) error {
+	if hang.Status() == Won {
+		fmt.Println(T("classic.won"))
 	} else {
-		fmt.Println("The word was " + game.ToFind + ". You'll do better next time!!!")
+		fmt.Println(T("classic.lost", hang.ToFind))
 	}
+	return nil
 }
 
 // Handling arguments and adding values to Game structure parameters
-func SortArguments() Game {
-	var game Game
-	arguments := os.Args[1:]
-	needFile := true                    // If needFile is true, this means that the last argument given is an option requesting a file.
-	for index, arg := range arguments { // Review all the arguments
-		switch arg {
-		case "--startWith", "-sw":
-			if needFile && index != 0 {
-				fmt.Println("Invalid argument")
-				os.Exit(3)
-			} else {
-				needFile = true
-			}
-			game.save = true
-			if len(arguments) > index+1 {
-				game.saveFile = arguments[index+1] // The backup file is saved in game.SaveFile
-			} else {
-				fmt.Println("Invalid argument")
-				os.Exit(3)
-			}
+func SortArguments() (Game, error) {
+	return ParseArguments(os.Args[1:])
+}
 
-		case "--classic", "-c":
-			if needFile && index != 0 {
-				os.Exit(3)
-			} else {
-				needFile = false
-			}
-			if game.ascii {
-				fmt.Println("Two arguments not compatible")
-				os.Exit(4)
-			} else {
-				game.classic = true
-			}
-		case "--ascii", "-a":
-			if needFile && index != 0 {
-				fmt.Println("Invalid argument")
-				os.Exit(3)
-			} else {
-				needFile = false
-			}
-			if game.classic { // Cause GameAscii is not compatible with GameClassic
-				fmt.Println("Two arguments not compatible")
-				os.Exit(4)
-			} else {
-				game.ascii = true
-			}
-		case "--letterFile", "-lf":
-			if needFile && index != 0 {
-				fmt.Println("Invalid argument")
-				os.Exit(3)
-			} else {
-				needFile = true
-			}
-			if game.classic { // Cause letterFile is not compatible with GameClassic
-				fmt.Println("Two arguments not compatible")
-				os.Exit(4)
-			} else {
-				game.letter = true
-			}
-			if len(arguments) > index+1 {
-				game.letterFile = arguments[index+1] // The ascii art font file is saved in game.letterFile
-			} else {
-				fmt.Println("Invalid argument")
-				os.Exit(3)
-			}
-		case "--rules", "-r":
-			if index != 0 || len(arguments) != 1 {
-				fmt.Println("Invalid argument")
-				os.Exit(3)
-			}
-			Rules()
-			os.Exit(0)
-		case "--help", "-h":
-			if index != 0 || len(arguments) != 1 {
-				fmt.Println("Invalid argument")
-				os.Exit(3)
-			}
-			Help()
-			os.Exit(0)
-		default:
-			if needFile && index == 0 {
-				game.dico = arguments[0]
-			} else if !needFile {
-				fmt.Println("Invalid argument")
-				os.Exit(3)
-			}
-			needFile = false
+// Using the arguments, generates HangManData's parameter values and launches the chosen game mode
+func ExploitingArgument(game Game) error {
+	if game.resources != "" {
+		SetResourceDir(game.resources)
+	}
+	if game.lang != "" {
+		if err := SetLocale(game.lang); err != nil {
+			return err
 		}
+	} else {
+		SetLocale(DetectLocale()) // English if there is no pack for the locale of the environment
+	}
+	if game.help {
+		return Help()
+	}
+	if game.rules {
+		return DisplayRules()
 	}
-	return game
-}
-
-// Using the arguments, generates HangManData's parameter values
-func ExploitingArgument(game Game) {
 	var data HangManData
-	if game.save { // Set HangManData
+	recovered := false
+	if !game.scripted() { // Offer to resume an interrupted game, the batch and json modes never ask
 		var err error
-		data, err = Load("Ressources/Save/" + game.saveFile)
+		if data, recovered, err = RecoverJournal(JournalFile()); err != nil {
+			return err
+		}
+	}
+	slots := NewSlotManager(SaveDir)
+	if game.resume && !game.save && !recovered && !game.scripted() { // The resume command without slot offers the saved games
+		name, err := slots.Pick()
 		if err != nil {
-			fmt.Println("Error while loading the game state:", err)
-			os.Exit(2)
+			return err
 		}
+		game.save, game.saveFile = name != "", name
+	}
+	if recovered {
+		data.Journal = JournalFile()
+	} else if game.resume && !game.save {
+		return ErrNothingToResume
+	} else if game.save { // Set HangManData
+		var err error
+		data, err = slots.Load(game.saveFile)
+		if err != nil {
+			return fmt.Errorf("%s: %w", T("error.loading"), err)
+		}
+		data.Slot = strings.TrimSuffix(game.saveFile, slotExtension)
+		data.LoadedSlot = data.Slot
 	} else {
-		data.SetData()
-		dico := ReadTheDico(game.dico)
-		data.SetWord(dico)
+		rules, err := RulesFor(game.difficulty)
+		if err != nil {
+			return err
+		}
+		data.SetData(rules)
+		data.IgnoreAccents = game.noAccent
+		data.Seed = game.seed
+		dico, err := ReadTheDico(game.dico)
+		if errors.Is(err, ErrUnknownDictionary) { // The words are chosen in every dictionary if the player accepts
+			if err := game.confirm(T("prompt.dictionary"), err); err != nil {
+				return err
+			}
+			dico, err = ReadAllDico()
+		}
+		if err != nil {
+			return err
+		}
+		if game.noRepeat { // The order of the words is kept in SaveDir from a game to the next one
+			picker, err := LoadWordPicker(PickerFile(game.dico), dico, 0)
+			if err != nil {
+				return err
+			}
+			data.SetWordFrom(picker)
+			if err := picker.Save(PickerFile(game.dico)); err != nil {
+				return err
+			}
+		} else if err := data.SetWord(dico); err != nil {
+			return err
+		}
+		data.Dictionary = game.dico
+	}
+	if game.player != "" {
+		if _, err := slots.path(game.player); err != nil {
+			return err
+		}
+		data.Slot = game.player
 	}
 	if !game.letter {
 		game.letterFile = "standard.txt"
 	} else {
-		if game.letterFile != "standard.txt" && game.letterFile != "thinkertoy.txt" && game.letterFile != "shadow.txt" {
-			fmt.Println("Unrecognized letterFile (i.e. letterFile will be standard.txt)\nPress enter to accept, otherwise ^C")
-			var inputs string
-			fmt.Scanln(&inputs)
+		if _, err := fs.Stat(resources, fontDir+"/"+game.letterFile); err != nil { // Any font of Ascii_Letter, .flf ones included
+			if err := game.confirm(T("prompt.letterFile"), err); err != nil {
+				return err
+			}
+			game.letterFile = "standard.txt"
+		}
+	}
+	if game.hangFile != "" {
+		if _, err := fs.Stat(resources, hangmanDir+"/"+game.hangFile); err != nil {
+			if err := game.confirm(T("prompt.hangmanFile", DefaultHangman), err); err != nil {
+				return err
+			}
+			game.hangFile = ""
 		}
 	}
-	if game.classic {
-		data.ClassicGame()
-		os.Exit(0)
+	data.HangmanFile = game.hangFile
+	if !game.scripted() { // The games of the scripts are not the player's ones
+		data.History = StatsFile()
+	}
+	if game.autosave {
+		data.Journal = JournalFile()
 	}
-	if game.ascii {
-		data.AsciiGame(game)
-		os.Exit(0)
+	var err error
+	switch {
+	case game.classic:
+		data.Mode = "classic"
+		err = data.ClassicGame()
+	case game.ascii:
+		data.Mode = "ascii"
+		err = data.AsciiGame(game)
+	case game.accessible:
+		data.Mode = "accessible"
+		err = data.AccessibleGame()
+	case game.batch:
+		data.Mode = "batch"
+		err = data.scriptFrom(HangManData.BatchGame, game.input)
+	case game.json:
+		data.Mode = "json"
+		err = data.scriptFrom(HangManData.JSONGame, game.input)
+	default: // If no mode is launched, the default mode is TermboxGame
+		data.Mode = "termbox"
+		err = data.TermBoxGame(game)
 	}
-	data.TermBoxGame(game) // If no mode is launched, the default mode is TermboxGame
+	if err != nil { // The journal is kept to resume the game
+		return err
+	}
+	return data.ClearJournal() // The game was left normally
 }
 
 // This function reads the given ascii file and returns a [95][9]string containing the ascii art characters.
-func ReadAscii(fichier string) [95][9]string {
+// The file must have 95 characters (from ' ' to '~'), each one is an empty line followed by 8 lines of drawing.
+func ReadAscii(fichier string) ([95][9]string, error) {
 	var ascii [95][9]string
 
-	readFile, err := os.Open(fichier)
+	lines, err := readLines(fichier)
 	if err != nil {
-		fmt.Print(err)
+		return ascii, err
 	}
+	lines = trimEmptyEnd(lines, len(ascii)*len(ascii[0]))
 
-	fileScanner := bufio.NewScanner(readFile) // Creates a scanner to read the file.
-
-	fileScanner.Split(bufio.ScanLines) // Divides the file into lines.
-
-	i, j := 0, 0
-	// Browse each line of the file.
-	for fileScanner.Scan() {
-		ascii[i][j] = fileScanner.Text()
-		j++
-		if j == 9 {
-			i++
-			j = 0
+	// Browse each character of the file.
+	for i := 0; i < len(ascii) && i*9 < len(lines); i++ {
+		if lines[i*9] != "" { // A character that isn't 8 lines high moves the empty lines (the blank lines of a drawing have spaces)
+			return ascii, &ResourceError{File: fichier, Line: i*9 + 1, Err: localErrorf("resource.asciiStart", rune(i+32))}
 		}
 	}
+	if len(lines) > len(ascii)*len(ascii[0]) {
+		return ascii, &ResourceError{File: fichier, Line: len(ascii)*len(ascii[0]) + 1, Err: localErrorf("resource.asciiLong")}
+	}
+	if len(lines) < len(ascii)*len(ascii[0]) {
+		return ascii, &ResourceError{File: fichier, Err: localErrorf("resource.asciiShort", len(lines))}
+	}
+	for i := range ascii {
+		copy(ascii[i][:], lines[i*9:i*9+9])
+	}
 
-	readFile.Close()
-
-	return ascii
+	return ascii, nil
 }
 
-// This function reads the given hangman file and returns a [10][8]string containing the hangman position.
-func ReadHang(fichier string) [10][8]string {
-	var hangman [10][8]string
-
-	readFile, err := os.Open(fichier)
-	if err != nil {
-		fmt.Print(err)
-	}
-
-	fileScanner := bufio.NewScanner(readFile) // Creates a scanner to read the file.
+//########### Dictionary function ##################
 
-	fileScanner.Split(bufio.ScanLines) // Divides the file into lines.
+// The readfile function returns an array of strings containing all the words in a dictionary
+func ReadFile(fichier string) ([]string, error) {
+	return readLines(fichier)
+}
 
-	i, j := 0, 0
-	// Browse each line of the file.
-	for fileScanner.Scan() {
-		// Adds the text of the current line to the FindWord slice.
-		hangman[i][j] = fileScanner.Text()
-		j++
-		if j == 8 {
-			i++
-			j = 0
+// Open the given resource, the error is a ResourceError
+func openResource(fichier string) (fs.File, error) {
+	readFile, err := resources.Open(fichier)
+	if err != nil {
+		var pathErr *fs.PathError
+		if errors.As(err, &pathErr) { // The path is already in the ResourceError
+			err = pathErr.Err
 		}
+		return nil, &ResourceError{File: fichier, Err: err}
 	}
-
-	readFile.Close()
-
-	return hangman
+	return readFile, nil
 }
 
-//########### Dictionary function ##################
+// Return the lines of the given resource
+func readLines(fichier string) ([]string, error) {
+	var lines []string
 
-// The readfile function returns an array of strings containing all the words in a dictionary
-func ReadFile(fichier string) []string {
-	var dictio []string
-
-	readFile, err := os.Open(fichier)
+	readFile, err := openResource(fichier)
 	if err != nil {
-		fmt.Print(err)
+		return nil, err
 	}
+	defer readFile.Close()
 
 	fileScanner := bufio.NewScanner(readFile) // Creates a scanner to read the file.
 
@@ -829,106 +1023,208 @@ func ReadFile(fichier string) []string {
 
 	// Browse each line of the file.
 	for fileScanner.Scan() {
-		// Adds the text of the current line to the FindWord slice.
-		dictio = append(dictio, fileScanner.Text())
+		lines = append(lines, fileScanner.Text())
+	}
+	if err := fileScanner.Err(); err != nil {
+		return nil, &ResourceError{File: fichier, Line: len(lines) + 1, Err: err}
 	}
 
-	readFile.Close()
+	return lines, nil
+}
 
-	return dictio
+// Removes the empty lines at the end of the file that come after the expected number of lines
+func trimEmptyEnd(lines []string, expected int) []string {
+	for len(lines) > expected && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
 }
 
 // The listDictio function returns all files in the Dictinonary directory
-func ListDictio() []string {
+func ListDictio() ([]string, error) {
 	var listDico []string
-	entries, err := os.ReadDir("Ressources/Dictionary/")
+	entries, err := fs.ReadDir(resources, "Dictionary")
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	for _, e := range entries {
 		listDico = append(listDico, e.Name())
 	}
-	return listDico
+	return listDico, nil
 }
 
 // The ReadAllDico function returns an array of strings containing all the words in the various files in the dictionary folder.
-func ReadAllDico() []string {
-	listDico := ListDictio()
+func ReadAllDico() ([]string, error) {
+	listDico, err := ListDictio()
+	if err != nil {
+		return nil, err
+	}
 	var dico []string
 	for i := 0; i < len(listDico); i++ {
-		newDico := ReadFile("Ressources/Dictionary/" + listDico[i])
+		newDico, err := ReadFile("Dictionary/" + listDico[i])
+		if err != nil {
+			return nil, err
+		}
 		dico = append(dico, newDico...)
 	}
-	return dico
+	return dico, nil
 }
 
-// This function returns an array of words, depending on the file entered as a parameter only if the file exists, otherwise it uses the other files.
-func ReadTheDico(file string) []string {
-	listDico := ListDictio()
+// This function returns an array of words, depending on the file entered as a parameter, or the words of every file if it is empty.
+// ErrUnknownDictionary is returned if there is no such file.
+func ReadTheDico(file string) ([]string, error) {
+	listDico, err := ListDictio()
+	if err != nil {
+		return nil, err
+	}
 	if listDico == nil {
-		fmt.Println("No file in Dictionary")
-		os.Exit(3)
+		return nil, ErrNoDictionary
+	}
+	if file == "" {
+		return ReadAllDico()
 	}
 	for _, j := range listDico { // Check if the requested dictionary exists
 		if file == j {
-			dico := ReadFile("Ressources/Dictionary/" + file)
-			return dico
+			return ReadFile("Dictionary/" + file)
 		}
 	}
-	fmt.Println("Unspecified or unrecognized dictionaries (i.e. words chosen at random from all dictionaries)\nPress enter to accept, otherwise ^C")
-	var inputs string
-	fmt.Scanln(&inputs)
-	return ReadAllDico()
+	return nil, fmt.Errorf("%w: %q", ErrUnknownDictionary, file)
+}
+
+// This function checks the dictionary of Ressources/Dictionary and returns its problems: no word,
+// lines without any letter to find and words given twice. The error is returned if the file can't be read.
+func ValidateDictionary(name string) ([]error, error) {
+	fichier := "Dictionary/" + name
+	lines, err := ReadFile(fichier)
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []error
+	words := map[string]int{} // Line of the first occurrence of each word
+	for i, line := range lines {
+		word := strings.TrimSpace(line)
+		if word == "" {
+			continue
+		}
+		if !strings.ContainsFunc(word, IsGuessable) {
+			problems = append(problems, &ResourceError{File: fichier, Line: i + 1, Err: localErrorf("resource.noLetter", word)})
+			continue
+		}
+		if first, ok := words[strings.ToLower(word)]; ok {
+			problems = append(problems, &ResourceError{File: fichier, Line: i + 1, Err: localErrorf("resource.duplicate", word, first)})
+			continue
+		}
+		words[strings.ToLower(word)] = i + 1
+	}
+	if len(words) == 0 {
+		problems = append(problems, &ResourceError{File: fichier, Err: ErrEmptyDictionary})
+	}
+	return problems, nil
 }
 
-// Main mecanic of the game which gathers several functions, return true if the game is finished, otherwise false.
-func (hang *HangManData) MainMecanics(input string) bool {
-	if utf8.RuneCountInString(input) > 1 { // If it's a word
+// Plays the input given by the player and returns what it changed in the game.
+// The STOP and QUIT commands are left to the front ends.
+func (hang *HangManData) Guess(input string) (GuessResult, error) {
+	input = strings.TrimSpace(input)
+	result := GuessResult{Input: input, Kind: LetterGuess, Status: hang.Status()}
+	if utf8.RuneCountInString(input) > 1 {
+		result.Kind = WordGuess
+	}
+	if hang.foldWord(input) == "" { // Empty, or only spaces and punctuation
+		return result, ErrInvalidInput
+	}
+	if result.Status != InProgress {
+		return result, ErrGameOver
+	}
+	if hang.UsedVerif(input) || result.Kind == LetterGuess && hang.isShown([]rune(input)[0]) {
+		result.Duplicate = true
+		return result, ErrAlreadyGuessed
+	}
+
+	attempts := hang.Attempts
+	if result.Kind == WordGuess { // If it's a word
 		if hang.IsThisTheWord(input) {
+			result.Positions = hang.HiddenPositions() // Every hidden position is revealed
 			hang.Word = []rune(hang.ToFind)
 			hang.LastFail = false
-			return true
-		} else if input == "STOP" { // If the input is STOP, save the game
-			err := hang.Save("Ressources/Save/save.txt")
-			if err != nil {
-				termbox.Close()
-				fmt.Println("Game save failed :", err)
-				os.Exit(2)
-			}
-			termbox.Close()
-			fmt.Println("Game save in save.txt")
-			os.Exit(0)
-		} else if input == "QUIT" { // If the input is QUIT, quit the game
-			termbox.Close()
-			os.Exit(0)
 		} else {
 			hang.UsedWord(input)
-			hang.Attempts -= 2
-			hang.HangmanPositions += 2
-			hang.LastFail = true
-			if hang.HangmanPositions > 9 { // Avoid out of range
-				hang.HangmanPositions = 9
-				hang.Attempts = 0
-			}
+			hang.loseAttempts(hang.Rules.WordCost)
 		}
 	} else { // If it's a letter
 		oneRune := []rune(input)
-		hang.LetterInWord(oneRune[0])
+		hidden := hang.HiddenPositions() // The positions already shown are not revealed by the guess
+		for _, index := range hang.LetterInWord(oneRune[0]) {
+			if slices.Contains(hidden, index) {
+				result.Positions = append(result.Positions, index)
+			}
+		}
 		hang.UsedLetter(oneRune[0])
 	}
-	return false
+
+	result.Revealed = len(result.Positions)
+	result.Cost = attempts - hang.Attempts
+	result.Status = hang.Status()
+	return result, nil
 }
 
-// Check if the game is finished or not
-func (game *HangManData) EndGame() bool {
+// Main mecanic of the game, return true if the game is won with the input, otherwise false.
+func (hang *HangManData) MainMecanics(input string) (bool, error) {
+	result, err := hang.Guess(input)
+	return result.Status == Won, err
+}
+
+// Return the save slot of the game
+func (hang *HangManData) slot() string {
+	if hang.Slot == "" {
+		return DefaultSlot
+	}
+	return hang.Slot
+}
+
+// Handles the STOP and QUIT commands of the terminal modes, return true if the game must be left
+func (hang *HangManData) stopCommand(input string) (bool, error) {
+	switch input {
+	case "STOP": // Save the game
+		if err := NewSlotManager(SaveDir).Save(hang.slot(), *hang); err != nil {
+			return true, fmt.Errorf("%s: %w", T("error.saveFailed"), err)
+		}
+		if hang.LoadedSlot != hang.slot() { // The game is now in its new slot only
+			return true, hang.clearSlot()
+		}
+		return true, nil
+	case "QUIT": // Quit the game
+		return true, nil
+	}
+	return false, nil
+}
+
+// Return the status of the game
+func (game *HangManData) Status() GameStatus {
 	if game.Attempts <= 0 { // No more attempts
-		return true
+		return Lost
+	}
+	if len(game.HiddenPositions()) != 0 {
+		return InProgress
 	}
-	for _, runes := range game.Word {
-		if runes == '_' {
-			return false
+	return Won // Words found
+}
+
+// Check if the game is finished or not
+func (game *HangManData) EndGame() bool {
+	return game.Status() != InProgress
+}
+
+// Return the index(es) of the letters of Word still to find, an '_' of ToFind itself is not hidden
+func (game *HangManData) HiddenPositions() []int {
+	var place []int
+	toFindRune := []rune(game.ToFind)
+	for index, runes := range game.Word {
+		if runes == '_' && (index >= len(toFindRune) || IsGuessable(toFindRune[index])) {
+			place = append(place, index)
 		}
 	}
-	return true // Words found
+	return place
 }
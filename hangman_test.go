@@ -0,0 +1,176 @@
+package hangman
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// Return a game of the word with the rules, every letter hidden
+func newTestGame(word string, rules Rules) *HangManData {
+	var hang HangManData
+	hang.SetData(rules)
+	hang.Seed = 1
+	hang.SetToFind(word)
+	return &hang
+}
+
+// Rules without letter revealed at the beginning
+var testRules = Rules{Attempts: 6, LetterCost: 1, WordCost: 2}
+
+func TestGuess(t *testing.T) {
+	tests := []struct {
+		name     string
+		word     string
+		before   []string // Guesses played before the tested one
+		input    string
+		err      error
+		expected GuessResult
+		shown    string // Word after the guess
+	}{
+		{name: "letter found", word: "hello", input: "l",
+			expected: GuessResult{Input: "l", Kind: LetterGuess, Revealed: 2, Positions: []int{2, 3}, Status: InProgress}, shown: "__ll_"},
+		{name: "letter missed", word: "hello", input: "z",
+			expected: GuessResult{Input: "z", Kind: LetterGuess, Cost: 1, Status: InProgress}, shown: "_____"},
+		{name: "word found", word: "hello", before: []string{"l"}, input: "hello",
+			expected: GuessResult{Input: "hello", Kind: WordGuess, Revealed: 3, Positions: []int{0, 1, 4}, Status: Won}, shown: "hello"},
+		{name: "word missed", word: "hello", input: "world",
+			expected: GuessResult{Input: "world", Kind: WordGuess, Cost: 2, Status: InProgress}, shown: "_____"},
+		{name: "spaces trimmed", word: "hello", input: " e ",
+			expected: GuessResult{Input: "e", Kind: LetterGuess, Revealed: 1, Positions: []int{1}, Status: InProgress}, shown: "_e___"},
+		{name: "last letter", word: "hello", before: []string{"h", "e", "l"}, input: "o",
+			expected: GuessResult{Input: "o", Kind: LetterGuess, Revealed: 1, Positions: []int{4}, Status: Won}, shown: "hello"},
+		{name: "last attempt", word: "hello", before: []string{"a", "b", "c", "d", "f"}, input: "g",
+			expected: GuessResult{Input: "g", Kind: LetterGuess, Cost: 1, Status: Lost}, shown: "_____"},
+		{name: "letter twice", word: "hello", before: []string{"z"}, input: "z", err: ErrAlreadyGuessed,
+			expected: GuessResult{Input: "z", Kind: LetterGuess, Status: InProgress, Duplicate: true}, shown: "_____"},
+		{name: "word twice", word: "hello", before: []string{"world"}, input: "world", err: ErrAlreadyGuessed,
+			expected: GuessResult{Input: "world", Kind: WordGuess, Status: InProgress, Duplicate: true}, shown: "_____"},
+		{name: "empty", word: "hello", input: "  ", err: ErrInvalidInput,
+			expected: GuessResult{Input: "", Kind: LetterGuess, Status: InProgress}, shown: "_____"},
+		{name: "punctuation", word: "hello", input: "-", err: ErrInvalidInput,
+			expected: GuessResult{Input: "-", Kind: LetterGuess, Status: InProgress}, shown: "_____"},
+		{name: "game over", word: "hello", before: []string{"hello"}, input: "a", err: ErrGameOver,
+			expected: GuessResult{Input: "a", Kind: LetterGuess, Status: Won}, shown: "hello"},
+		{name: "phrase letter", word: "ice cream", input: "c",
+			expected: GuessResult{Input: "c", Kind: LetterGuess, Revealed: 2, Positions: []int{1, 4}, Status: InProgress}, shown: "_c_ c____"},
+		{name: "phrase found", word: "ice cream", input: "ice cream",
+			expected: GuessResult{Input: "ice cream", Kind: WordGuess, Revealed: 8, Positions: []int{0, 1, 2, 4, 5, 6, 7, 8}, Status: Won}, shown: "ice cream"},
+		{name: "phrase without punctuation", word: "rock'n'roll", input: "rocknroll",
+			expected: GuessResult{Input: "rocknroll", Kind: WordGuess, Revealed: 9, Positions: []int{0, 1, 2, 3, 5, 7, 8, 9, 10}, Status: Won}, shown: "rock'n'roll"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hang := newTestGame(test.word, testRules)
+			for _, input := range test.before {
+				if _, err := hang.Guess(input); err != nil {
+					t.Fatalf("Guess(%q) = %v", input, err)
+				}
+			}
+			result, err := hang.Guess(test.input)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("Guess(%q) error = %v, expected %v", test.input, err, test.err)
+			}
+			if !slices.Equal(result.Positions, test.expected.Positions) {
+				t.Errorf("Guess(%q) positions = %v, expected %v", test.input, result.Positions, test.expected.Positions)
+			}
+			result.Positions, test.expected.Positions = nil, nil
+			if !reflect.DeepEqual(result, test.expected) {
+				t.Errorf("Guess(%q) = %+v, expected %+v", test.input, result, test.expected)
+			}
+			if string(hang.Word) != test.shown {
+				t.Errorf("Word = %q, expected %q", string(hang.Word), test.shown)
+			}
+		})
+	}
+}
+
+func TestGuessRevealedLetter(t *testing.T) {
+	hang := newTestGame("hello", testRules)
+	hang.Word = []rune("__ll_") // Revealed at the beginning, without being in ListLetter (HardRules)
+	result, err := hang.Guess("l")
+	if !errors.Is(err, ErrAlreadyGuessed) || !result.Duplicate {
+		t.Fatalf("Guess of a revealed letter = %+v, %v, expected ErrAlreadyGuessed", result, err)
+	}
+	result, err = hang.Guess("o")
+	if err != nil || !slices.Equal(result.Positions, []int{4}) {
+		t.Errorf("Guess(\"o\") = %+v, %v, expected the position 4 only", result, err)
+	}
+}
+
+// Return a font of 95 characters in the format of ReadAscii, edit changes its lines
+func asciiFont(edit func(lines []string) []string) string {
+	var lines []string
+	for char := ' '; char <= '~'; char++ {
+		lines = append(lines, "")
+		for i := 0; i < 8; i++ {
+			lines = append(lines, string(char))
+		}
+	}
+	return strings.Join(edit(lines), "\n") + "\n"
+}
+
+func TestReadAscii(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		line    int // Line of the ResourceError, 0 for the whole file, -1 for no error
+	}{
+		{"font", asciiFont(func(lines []string) []string { return lines }), -1},
+		{"empty lines at the end", asciiFont(func(lines []string) []string { return append(lines, "", "") }), -1},
+		{"character too high", asciiFont(func(lines []string) []string { return slices.Insert(lines, 12, "!") }), 19},
+		{"character too short", asciiFont(func(lines []string) []string { return slices.Delete(lines, 30, 31) }), 37},
+		{"too long", asciiFont(func(lines []string) []string { return append(lines, "~") }), 856},
+		{"too short", asciiFont(func(lines []string) []string { return lines[:len(lines)-9] }), 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setTestResources(t, fstest.MapFS{"font.txt": {Data: []byte(test.content)}})
+			ascii, err := ReadAscii("font.txt")
+			checkResourceError(t, err, test.line)
+			if err == nil && (ascii[1][1] != "!" || ascii[94][8] != "~") {
+				t.Errorf("ReadAscii gave %q for '!' and %q for '~'", ascii[1], ascii[94])
+			}
+		})
+	}
+}
+
+func TestValidateDictionary(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		lines   []int // Lines of the problems, 0 for the whole file
+	}{
+		{"valid", "hello\nworld\n\nl'eau\n", nil},
+		{"duplicate", "hello\nworld\nHello\n", []int{3}},
+		{"no letter", "hello\n?!\n--\n", []int{2, 3}},
+		{"empty", "\n  \n...\n", []int{3, 0}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setTestResources(t, fstest.MapFS{"Dictionary/test.txt": {Data: []byte(test.content)}})
+			problems, err := ValidateDictionary("test.txt")
+			if err != nil {
+				t.Fatalf("ValidateDictionary = %v", err)
+			}
+			var lines []int
+			for _, problem := range problems {
+				var resourceErr *ResourceError
+				if !errors.As(problem, &resourceErr) {
+					t.Fatalf("ValidateDictionary gave %v, expected a ResourceError", problem)
+				}
+				lines = append(lines, resourceErr.Line)
+			}
+			if !slices.Equal(lines, test.lines) {
+				t.Errorf("ValidateDictionary gave problems at the lines %v, expected %v: %v", lines, test.lines, problems)
+			}
+		})
+	}
+
+	if _, err := ValidateDictionary("missing.txt"); err == nil {
+		t.Errorf("ValidateDictionary of a missing dictionary = nil, expected an error")
+	}
+}
@@ -0,0 +1,164 @@
+package hangman
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Directory of the language packs in the resources, a pack is "<locale>.txt" (ex: "fr.txt")
+const localeDir = "Locale"
+
+// Locale used when no other one is chosen, its pack is also used for the messages missing in the others
+const DefaultLocale = "en"
+
+// Catalog is a language pack: the messages by key
+type Catalog map[string]string
+
+var (
+	localeMu sync.RWMutex
+	locale   = DefaultLocale
+	catalog  Catalog // Messages of locale, set by SetLocale
+	fallback Catalog // Messages of DefaultLocale, read on first use
+)
+
+// This function reads the language pack of the given locale, lines "key = value" (see readKeyValues).
+// A value between double quotes is read as a Go string, to keep its spaces or write "\n".
+func LoadCatalog(name string) (Catalog, error) {
+	fichier := localeDir + "/" + name + ".txt"
+	readFile, err := openResource(fichier)
+	if err != nil {
+		return nil, err
+	}
+	defer readFile.Close()
+
+	entries, err := readKeyValues(readFile, fichier)
+	if err != nil {
+		return nil, err
+	}
+	messages := Catalog{}
+	for _, entry := range entries {
+		value := entry.Value
+		if strings.HasPrefix(value, `"`) {
+			if value, err = strconv.Unquote(value); err != nil {
+				return nil, &ResourceError{File: fichier, Line: entry.Line, Err: localErrorf("resource.invalidQuoted", entry.Key)}
+			}
+		}
+		messages[entry.Key] = value
+	}
+	return messages, nil
+}
+
+// Return the locales that have a language pack
+func Locales() ([]string, error) {
+	entries, err := fs.ReadDir(resources, localeDir)
+	if err != nil {
+		return nil, err
+	}
+	var locales []string
+	for _, e := range entries {
+		if name, ok := strings.CutSuffix(e.Name(), ".txt"); ok {
+			locales = append(locales, name)
+		}
+	}
+	return locales, nil
+}
+
+// SetLocale chooses the language of the messages. The locale can be given as in LANG ("fr_FR.UTF-8"):
+// the pack "fr_FR" is used if there is one, otherwise "fr".
+func SetLocale(name string) error {
+	name, _, _ = strings.Cut(name, ".") // Without the encoding
+	name, _, _ = strings.Cut(name, "@") // Without the modifier
+	candidates := []string{name}
+	if language, _, ok := strings.Cut(name, "_"); ok {
+		candidates = append(candidates, language)
+	}
+
+	for _, candidate := range candidates {
+		messages, err := LoadCatalog(candidate)
+		if err != nil {
+			continue
+		}
+		localeMu.Lock()
+		locale, catalog = candidate, messages
+		localeMu.Unlock()
+		return nil
+	}
+	return fmt.Errorf("%w: %q", ErrUnknownLocale, name)
+}
+
+// Return the locale of the messages
+func Locale() string {
+	localeMu.RLock()
+	defer localeMu.RUnlock()
+	return locale
+}
+
+// Return the locale of the environment: LC_ALL, then LC_MESSAGES, then LANG. DefaultLocale if none is set.
+func DetectLocale() string {
+	for _, variable := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if value := os.Getenv(variable); value != "" && value != "C" && value != "POSIX" {
+			return value
+		}
+	}
+	return DefaultLocale
+}
+
+// T returns the message of the key in the chosen locale, formatted with the arguments if there are some.
+// A message missing in the pack is taken from the DefaultLocale one, the key itself is returned if it is missing there too.
+func T(key string, args ...any) string {
+	message, ok := lookup(key)
+	if !ok {
+		message = key
+	}
+	if len(args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, args...)
+}
+
+// Return the message of the key, from the chosen pack or the default one
+func lookup(key string) (string, bool) {
+	localeMu.RLock()
+	messages := catalog
+	localeMu.RUnlock()
+	if message, ok := messages[key]; ok {
+		return message, true
+	}
+
+	localeMu.Lock()
+	if fallback == nil {
+		fallback, _ = LoadCatalog(DefaultLocale)
+	}
+	messages = fallback
+	localeMu.Unlock()
+	message, ok := messages[key]
+	return message, ok
+}
+
+// Return the count with the singular or plural message of the key ("<key>.one" or "<key>.other").
+// The count 0 takes the singular if the pack gives "plural.zero = one", as in French.
+func plural(count int, key string) string {
+	if count == 1 || (count == 0 && T("plural.zero") == "one") {
+		return T(key+".one", count)
+	}
+	return T(key+".other", count)
+}
+
+// Error of the package, its message is read from the language pack each time it is shown
+type localError struct {
+	key  string
+	args []any // Arguments of the message, if it has some
+}
+
+func (err *localError) Error() string {
+	return T(err.key, err.args...)
+}
+
+// Return an error with the message of the key formatted with the arguments, for the errors that are not variables (see T)
+func localErrorf(key string, args ...any) error {
+	return &localError{key: key, args: args}
+}
@@ -0,0 +1,94 @@
+package hangman
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+)
+
+func TestSetLocale(t *testing.T) {
+	setTestResources(t, Overlay(fstest.MapFS{
+		"Locale/fr_CA.txt": {Data: []byte("# Canadian French\nspell.blank = trou\n")},
+		"Locale/xx.txt":    {Data: []byte("spell.blank = x\nnot a message\n")},
+	}, Resources()))
+	setTestLocale(t, "en")
+
+	tests := []struct {
+		name   string
+		locale string // Locale chosen, empty if the name is refused
+		blank  string // Message of spell.blank once the name is given
+	}{
+		{"fr", "fr", "vide"},
+		{"fr_FR.UTF-8", "fr", "vide"},
+		{"fr_BE@euro", "fr", "vide"},
+		{"fr_CA", "fr_CA", "trou"},
+		{"en_US.UTF-8", "en", "blank"},
+		{"de_DE", "", "blank"},
+		{"xx", "", "blank"},
+	}
+	for _, test := range tests {
+		SetLocale("en")
+		err := SetLocale(test.name)
+		if test.locale == "" {
+			if !errors.Is(err, ErrUnknownLocale) || Locale() != "en" {
+				t.Errorf("SetLocale(%q) = %v, locale %q, expected ErrUnknownLocale and en kept", test.name, err, Locale())
+			}
+		} else if err != nil || Locale() != test.locale {
+			t.Errorf("SetLocale(%q) = %v, locale %q, expected %q", test.name, err, Locale(), test.locale)
+		}
+		if blank := T("spell.blank"); blank != test.blank {
+			t.Errorf("T(\"spell.blank\") after SetLocale(%q) = %q, expected %q", test.name, blank, test.blank)
+		}
+	}
+
+	SetLocale("fr_CA")
+	if message := T("spell.space"); message != "space" { // Missing in fr_CA, taken from the default pack
+		t.Errorf("T of a message missing in the pack = %q, expected the one of %s", message, DefaultLocale)
+	}
+	if message := T("missing.key"); message != "missing.key" {
+		t.Errorf("T of a missing key = %q, expected the key", message)
+	}
+}
+
+func TestLoadCatalog(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		line    int // Line of the ResourceError, -1 for no error
+	}{
+		{"catalog", "# comment\n\nkey = value\nquoted = \" a\\tb \"\n", -1},
+		{"not key value", "key = value\nvalue\n", 2},
+		{"invalid quoted", "key = \"value\n", 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setTestResources(t, fstest.MapFS{"Locale/test.txt": {Data: []byte(test.content)}})
+			messages, err := LoadCatalog("test")
+			checkResourceError(t, err, test.line)
+			if err == nil && (messages["key"] != "value" || messages["quoted"] != " a\tb " || len(messages) != 2) {
+				t.Errorf("LoadCatalog = %q", messages)
+			}
+		})
+	}
+}
+
+func TestPlural(t *testing.T) {
+	tests := []struct {
+		locale string
+		count  int
+		text   string
+	}{
+		{"en", 0, "0 attempts"},
+		{"en", 1, "1 attempt"},
+		{"en", 2, "2 attempts"},
+		{"fr", 0, "0 essai"},
+		{"fr", 1, "1 essai"},
+		{"fr", 2, "2 essais"},
+	}
+	for _, test := range tests {
+		setTestLocale(t, test.locale)
+		if text := plural(test.count, "count.attempt"); text != test.text {
+			t.Errorf("plural(%d) in %s = %q, expected %q", test.count, test.locale, text, test.text)
+		}
+	}
+}
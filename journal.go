@@ -0,0 +1,64 @@
+package hangman
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Return the journal written after every accepted guess when the autosave is on, it is left behind if the game is interrupted
+func JournalFile() string {
+	return filepath.Join(SaveDir, ".autosave"+slotExtension)
+}
+
+// Writes the game in its journal after an accepted guess, the journal is removed once the game is over
+func (hang *HangManData) writeJournal() error {
+	if hang.Journal == "" {
+		return nil
+	}
+	if hang.EndGame() {
+		return hang.ClearJournal()
+	}
+	if err := os.MkdirAll(filepath.Dir(hang.Journal), 0o755); err != nil {
+		return err
+	}
+	if err := hang.Save(hang.Journal); err != nil {
+		return fmt.Errorf("%s: %w", T("error.autosaveFailed"), err)
+	}
+	return nil
+}
+
+// Removes the journal of the game, when the game is over or has been left on purpose
+func (hang *HangManData) ClearJournal() error {
+	if hang.Journal == "" {
+		return nil
+	}
+	if err := os.Remove(hang.Journal); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Looks for a game interrupted during a previous launch and asks the player to resume it.
+// Return the game and true if it must be resumed, the journal is removed otherwise.
+func RecoverJournal(journal string) (HangManData, bool, error) {
+	if _, err := os.Stat(journal); err != nil {
+		return HangManData{}, false, nil
+	}
+	data, err := Load(journal)
+	if err != nil || data.EndGame() { // Nothing to resume
+		return HangManData{}, false, os.Remove(journal)
+	}
+
+	fmt.Println(T("journal.found", data.Attempts))
+	answer, err := ReadLine(T("journal.resume"))
+	if err != nil { // The journal is kept, the question will be asked again
+		return HangManData{}, false, err
+	}
+	if strings.HasPrefix(strings.ToLower(answer), T("journal.yes")) {
+		return data, true, nil
+	}
+	return HangManData{}, false, os.Remove(journal)
+}
@@ -0,0 +1,130 @@
+package hangman
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Read the answers of the player from text
+func setTestInput(t *testing.T, text string) {
+	t.Helper()
+	previous := stdin
+	stdin = bufio.NewReader(strings.NewReader(text))
+	t.Cleanup(func() { stdin = previous })
+}
+
+// Drop what is written on the standard output, the questions to the player
+func discardOutput(t *testing.T) {
+	t.Helper()
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous := os.Stdout
+	os.Stdout = devNull
+	t.Cleanup(func() {
+		os.Stdout = previous
+		devNull.Close()
+	})
+}
+
+func TestWriteJournal(t *testing.T) {
+	setTestKey(t)
+	journal := filepath.Join(t.TempDir(), "saves", ".autosave.txt")
+	hang := newTestGame("hello", testRules)
+	if err := hang.writeJournal(); err != nil { // Without journal nothing is written
+		t.Fatalf("writeJournal without journal = %v", err)
+	}
+
+	hang.Journal = journal
+	for _, input := range []string{"l", "z"} {
+		if _, err := hang.Guess(input); err != nil {
+			t.Fatal(err)
+		}
+		if err := hang.writeJournal(); err != nil {
+			t.Fatalf("writeJournal = %v", err)
+		}
+		saved, err := Load(journal)
+		if err != nil {
+			t.Fatalf("Load of the journal = %v", err)
+		}
+		saved.Journal = journal
+		if !reflect.DeepEqual(saved, *hang) {
+			t.Errorf("journal = %+v, expected %+v", saved, *hang)
+		}
+	}
+
+	if _, err := hang.Guess("hello"); err != nil {
+		t.Fatal(err)
+	}
+	if err := hang.writeJournal(); err != nil {
+		t.Fatalf("writeJournal of a finished game = %v", err)
+	}
+	if _, err := os.Stat(journal); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("the journal of a finished game is kept: %v", err)
+	}
+	if err := hang.ClearJournal(); err != nil {
+		t.Errorf("ClearJournal of a removed journal = %v", err)
+	}
+}
+
+func TestRecoverJournal(t *testing.T) {
+	setTestKey(t)
+	discardOutput(t)
+	if err := SetLocale("en"); err != nil {
+		t.Fatal(err)
+	}
+	finished := newTestGame("hello", testRules)
+	finished.Guess("hello")
+
+	tests := []struct {
+		name    string
+		game    *HangManData // Written in the journal, nil for no journal
+		content string       // Written in the journal instead of the game
+		answer  string
+		err     error
+		resumed bool
+		kept    bool // True if the journal is still there
+	}{
+		{name: "no journal"},
+		{name: "yes", game: newTestGame("hello", testRules), answer: "y\n", resumed: true, kept: true},
+		{name: "yes in capitals", game: newTestGame("hello", testRules), answer: "YES\n", resumed: true, kept: true},
+		{name: "no", game: newTestGame("hello", testRules), answer: "n\n"},
+		{name: "no answer", game: newTestGame("hello", testRules), err: io.EOF, kept: true},
+		{name: "finished game", game: finished, answer: "y\n"},
+		{name: "edited journal", content: `{"version":2,"game":{}}`, answer: "y\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			journal := filepath.Join(t.TempDir(), ".autosave.txt")
+			switch {
+			case test.game != nil:
+				if err := test.game.Save(journal); err != nil {
+					t.Fatal(err)
+				}
+			case test.content != "":
+				if err := os.WriteFile(journal, []byte(test.content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			setTestInput(t, test.answer)
+
+			data, resumed, err := RecoverJournal(journal)
+			if !errors.Is(err, test.err) || resumed != test.resumed {
+				t.Fatalf("RecoverJournal = %v, %v, expected %v, %v", resumed, err, test.resumed, test.err)
+			}
+			if resumed && (data.ToFind != test.game.ToFind || data.Attempts != test.game.Attempts) {
+				t.Errorf("RecoverJournal = %+v, expected %+v", data, *test.game)
+			}
+			if _, err := os.Stat(journal); (err == nil) != test.kept {
+				t.Errorf("journal kept = %v, expected %v", err == nil, test.kept)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package hangman
+
+import (
+	"strings"
+
+	"github.com/nsf/termbox-go"
+)
+
+// Smallest terminal of the full layout, the compact one is used below
+const (
+	MinWidth  = 80
+	MinHeight = 24
+)
+
+// Rectangle of the terminal, in cells
+type Rect struct {
+	X, Y, Width, Height int
+}
+
+// Layout gives the place of each box of the termbox mode
+type Layout struct {
+	Compact  bool // True if the terminal is too small for the boxes, the game is then drawn as lines of text
+	Main     Rect // Whole terminal
+	Word     Rect // Word to find
+	Attempts Rect // Attempts left, in ascii art
+	Letter   Rect // Input of the player
+	Used     Rect // Letters and words already proposed
+	Hangman  Rect // Hangman drawing
+	Ascii    Rect // Last input, then the result, in ascii art
+}
+
+// Return the layout of a terminal of the given size: the left half has the word, the input and
+// the used letters, the right half the hangman and the last input. The boxes grow with the terminal.
+func NewLayout(width, height int) Layout {
+	layout := Layout{Main: Rect{0, 0, width, height}}
+	if width < MinWidth || height < MinHeight {
+		layout.Compact = true
+		return layout
+	}
+
+	left := width / 2
+	gap := 5 // Between the two halves
+	layout.Word = Rect{0, 0, left / 2, 8}
+	layout.Attempts = Rect{left / 2, 0, left - left/2, 8}
+	layout.Letter = Rect{0, 8, left, 8}
+	layout.Used = Rect{0, 16, left, height - 16}
+	layout.Hangman = Rect{left + gap, 0, width - left - gap, height - 9}
+	layout.Ascii = Rect{left + gap, height - 9, width - left - gap, 9}
+	return layout
+}
+
+// Return the layout of the current terminal
+func TerminalLayout() Layout {
+	return NewLayout(termbox.Size())
+}
+
+// Return the inside of the box, without its border and a margin of margin cells on the sides
+func (rect Rect) inside(margin int) Rect {
+	return Rect{rect.X + 1 + margin, rect.Y + 1, max(0, rect.Width-2-2*margin), max(0, rect.Height-2)}
+}
+
+// Return the text cut in lines of width runes at most, a line is cut between words when possible
+func wrapText(text string, width int) []string {
+	if width <= 0 {
+		return nil
+	}
+	var lines []string
+	line := []rune{}
+	for _, word := range strings.Split(text, " ") {
+		runes := []rune(word)
+		if len(line) != 0 && len(line)+1+len(runes) > width { // The word goes to the next line
+			lines = append(lines, string(line))
+			line = []rune{}
+		}
+		if len(line) != 0 {
+			line = append(line, ' ')
+		}
+		line = append(line, runes...)
+		for len(line) > width { // A word longer than the line is cut
+			lines = append(lines, string(line[:width]))
+			line = line[width:]
+		}
+	}
+	return append(lines, string(line))
+}
+
+// Draws the lines in the area, the lines that don't fit are replaced with "..." on the last row.
+// Return the number of rows used.
+func drawLines(lines []string, area Rect, color termbox.Attribute) int {
+	if len(lines) > area.Height && area.Height > 0 {
+		lines = append(lines[:area.Height-1:area.Height-1], "...")
+	}
+	for i, line := range lines {
+		if i >= area.Height {
+			return area.Height
+		}
+		for index, ch := range []rune(line) {
+			if index >= area.Width {
+				break
+			}
+			termbox.SetCell(area.X+index, area.Y+i, ch, color, termbox.ColorDefault)
+		}
+	}
+	return len(lines)
+}
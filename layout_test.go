@@ -0,0 +1,80 @@
+package hangman
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/nsf/termbox-go"
+)
+
+func TestNewLayout(t *testing.T) {
+	for _, size := range [][2]int{{79, 30}, {120, 23}, {0, 0}} {
+		if layout := NewLayout(size[0], size[1]); !layout.Compact {
+			t.Errorf("NewLayout(%d, %d) isn't compact", size[0], size[1])
+		}
+	}
+
+	for _, size := range [][2]int{{MinWidth, MinHeight}, {100, 24}, {200, 60}} {
+		width, height := size[0], size[1]
+		layout := NewLayout(width, height)
+		if layout.Compact {
+			t.Fatalf("NewLayout(%d, %d) is compact", width, height)
+		}
+		boxes := []Rect{layout.Word, layout.Attempts, layout.Letter, layout.Used, layout.Hangman, layout.Ascii}
+		for i, box := range boxes {
+			if box.X < 0 || box.Y < 0 || box.Width <= 2 || box.Height <= 2 || box.X+box.Width > width || box.Y+box.Height > height {
+				t.Errorf("NewLayout(%d, %d) has the box %+v out of the terminal", width, height, box)
+			}
+			for _, other := range boxes[i+1:] {
+				if box.X < other.X+other.Width && other.X < box.X+box.Width && box.Y < other.Y+other.Height && other.Y < box.Y+box.Height {
+					t.Errorf("NewLayout(%d, %d) has the boxes %+v and %+v over each other", width, height, box, other)
+				}
+			}
+		}
+		if layout.Used.Y+layout.Used.Height != height || layout.Ascii.Y+layout.Ascii.Height != height {
+			t.Errorf("NewLayout(%d, %d) doesn't fill the height: %+v", width, height, layout)
+		}
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	tests := []struct {
+		text  string
+		width int
+		lines []string
+	}{
+		{"abc def", 10, []string{"abc def"}},
+		{"abc def", 7, []string{"abc def"}},
+		{"abc def", 6, []string{"abc", "def"}},
+		{"abcdefgh ij", 3, []string{"abc", "def", "gh", "ij"}},
+		{"éèà ôù", 3, []string{"éèà", "ôù"}},
+		{"", 5, []string{""}},
+		{"abc", 0, nil},
+	}
+	for _, test := range tests {
+		if lines := wrapText(test.text, test.width); !slices.Equal(lines, test.lines) {
+			t.Errorf("wrapText(%q, %d) = %q, expected %q", test.text, test.width, lines, test.lines)
+		}
+	}
+}
+
+func TestDrawLines(t *testing.T) {
+	lines := []string{"a", "b", "c", "d"}
+	tests := []struct {
+		height int
+		used   int
+	}{
+		{10, 4},
+		{4, 4},
+		{3, 3}, // "a", "b" then "..."
+		{0, 0},
+	}
+	for _, test := range tests {
+		if used := drawLines(lines, Rect{0, 0, 10, test.height}, termbox.ColorDefault); used != test.used {
+			t.Errorf("drawLines in %d rows used %d rows, expected %d", test.height, used, test.used)
+		}
+	}
+	if lines[2] != "c" {
+		t.Errorf("drawLines changed the lines given: %q", lines)
+	}
+}
@@ -0,0 +1,178 @@
+package hangman
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// FlagSet returns the options of a game, their values are written in game when the flag set is parsed.
+// Every option has a long and a short name (--classic and -c), "-" and "--" can be used for both.
+func (game *Game) FlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard) // The errors are returned, the help is written by PrintFlags
+
+	option := func(long, short string) {
+		fs.Var(fs.Lookup(long).Value, short, "") // Same value, the short name has no usage
+	}
+	fs.BoolVar(&game.classic, "classic", false, T("flag.classic"))
+	option("classic", "c")
+	fs.BoolVar(&game.ascii, "ascii", false, T("flag.ascii"))
+	option("ascii", "a")
+	fs.BoolVar(&game.accessible, "accessible", false, T("flag.accessible"))
+	option("accessible", "ac")
+	fs.BoolVar(&game.batch, "batch", false, T("flag.batch"))
+	option("batch", "b")
+	fs.BoolVar(&game.json, "json", false, T("flag.json"))
+	option("json", "j")
+	fs.StringVar(&game.input, "input", "", T("flag.input"))
+	option("input", "i")
+	fs.StringVar(&game.letterFile, "letterFile", "", T("flag.letterFile"))
+	option("letterFile", "lf")
+	fs.StringVar(&game.hangFile, "hangmanFile", "", T("flag.hangmanFile"))
+	option("hangmanFile", "hf")
+	fs.StringVar(&game.theme, "theme", "", T("flag.theme"))
+	option("theme", "t")
+	fs.StringVar(&game.lang, "lang", "", T("flag.lang"))
+	option("lang", "lg")
+	fs.StringVar(&game.resources, "resources", "", T("flag.resources"))
+	option("resources", "rd")
+	fs.StringVar(&game.saveFile, "startWith", "", T("flag.startWith"))
+	option("startWith", "sw")
+	fs.StringVar(&game.player, "player", "", T("flag.player"))
+	option("player", "p")
+	fs.StringVar(&game.difficulty, "difficulty", "", T("flag.difficulty"))
+	option("difficulty", "d")
+	fs.Int64Var(&game.seed, "seed", 0, T("flag.seed"))
+	option("seed", "s")
+	fs.BoolVar(&game.noAccent, "ignoreAccents", false, T("flag.ignoreAccents"))
+	option("ignoreAccents", "ia")
+	fs.BoolVar(&game.autosave, "autosave", false, T("flag.autosave"))
+	option("autosave", "as")
+	fs.BoolVar(&game.noRepeat, "noRepeat", false, T("flag.noRepeat"))
+	option("noRepeat", "nr")
+	fs.BoolVar(&game.rules, "rules", false, T("flag.rules"))
+	option("rules", "r")
+	fs.BoolVar(&game.help, "help", false, T("flag.help"))
+	option("help", "h")
+	return fs
+}
+
+// ParseFlags parses the arguments with the flag set, the options can be given before and after
+// the other arguments. Return the other arguments.
+func ParseFlags(fs *flag.FlagSet, arguments []string) ([]string, error) {
+	var others []string
+	for {
+		if err := fs.Parse(arguments); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+		}
+		arguments = fs.Args()
+		if len(arguments) == 0 {
+			return others, nil
+		}
+		others = append(others, arguments[0]) // The parsing stopped at an argument that isn't an option
+		arguments = arguments[1:]
+	}
+}
+
+// ParseArguments returns the game of the arguments: options and the name of the dictionary.
+// The options that are not in the arguments come from the environment and the configuration file (see LoadSettings).
+func ParseArguments(arguments []string) (Game, error) {
+	var game Game
+	fs := game.FlagSet("hangman")
+	others, err := ParseFlags(fs, arguments)
+	if err != nil {
+		return game, err
+	}
+	if len(others) > 1 { // A single dictionary
+		return game, ErrInvalidArgument
+	}
+	if len(others) == 1 {
+		game.SetDictionary(others[0])
+	}
+	settings, err := LoadSettings()
+	if err != nil {
+		return game, err
+	}
+	if err := game.Apply(settings, fs); err != nil {
+		return game, err
+	}
+	return game, game.Check()
+}
+
+// SetDictionary chooses the dictionary of the word, a file of Ressources/Dictionary
+func (game *Game) SetDictionary(name string) {
+	game.dico = name
+}
+
+// Resume makes the game start from the slot, or from the interrupted game or a slot picked by the player if slot is empty.
+// ExploitingArgument then returns ErrNothingToResume instead of starting a new game.
+func (game *Game) Resume(slot string) {
+	game.resume = true
+	if slot != "" {
+		game.saveFile = slot
+	}
+}
+
+// Check returns ErrIncompatibleOptions if two options can't be used together
+func (game *Game) Check() error {
+	game.save = game.saveFile != ""
+	game.letter = game.letterFile != ""
+
+	modes := 0
+	for _, mode := range []bool{game.classic, game.ascii, game.accessible, game.batch, game.json} {
+		if mode {
+			modes++
+		}
+	}
+	switch {
+	case modes > 1: // A single game mode
+		return ErrIncompatibleOptions
+	case game.letter && (game.classic || game.accessible || game.scripted()): // The font is used by the termbox and ascii modes
+		return ErrIncompatibleOptions
+	case game.input != "" && !game.scripted(): // The other modes read the terminal
+		return ErrIncompatibleOptions
+	case game.theme != "" && modes != 0: // The themes are the colors of the termbox mode
+		return ErrIncompatibleOptions
+	case game.noRepeat && game.seed != 0: // The word comes from the order kept between the games, not from the seed
+		return ErrIncompatibleOptions
+	}
+	return nil
+}
+
+// Return true for the batch and json modes: the guesses are read from a file or the standard input and nothing is asked
+func (game *Game) scripted() bool {
+	return game.batch || game.json
+}
+
+// PrintFlags writes the help of the options of the flag set, each long name is given with its short name
+func PrintFlags(w io.Writer, fs *flag.FlagSet) {
+	fs.VisitAll(func(f *flag.Flag) {
+		if f.Usage == "" { // Short name, written with the long one
+			return
+		}
+		names := "--" + f.Name
+		fs.VisitAll(func(short *flag.Flag) {
+			if short != f && short.Value == f.Value {
+				names = "-" + short.Name + ", " + names
+			}
+		})
+		valueName, usage := flag.UnquoteUsage(f)
+		if valueName != "" {
+			names += " " + valueName
+		}
+		fmt.Fprintf(w, "  %s\n      %s\n", names, strings.ReplaceAll(usage, "\n", "\n      "))
+	})
+}
+
+// Return true if the error comes from the arguments of the program
+func IsUsageError(err error) bool {
+	for _, usage := range []error{ErrInvalidArgument, ErrIncompatibleOptions, ErrUnknownRules, ErrUnknownTheme, ErrUnknownLocale, ErrInvalidSlot, ErrSlotExists, ErrUnknownDictionary} {
+		if errors.Is(err, usage) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package hangman
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// WordPicker chooses the words of a session (several games in a row) in a dictionary.
+// In no-repeat mode it goes through the shuffled dictionary, so a word comes back only once all the others were given.
+// The order can be kept between the launches of the program with Save and LoadWordPicker (see the --noRepeat option).
+type WordPicker struct {
+	words    []string   // Words of the dictionary, without empty lines
+	random   *rand.Rand // Random source of the session
+	noRepeat bool       // True if a word can't come back before the end of the dictionary
+	order    []int      // Shuffled index of words, used by the no-repeat mode
+	next     int        // Position in order of the next word
+}
+
+// Return a picker for the given dictionary, seed 0 picks a random one
+func NewWordPicker(dico []string, seed int64, noRepeat bool) (*WordPicker, error) {
+	words := CleanDico(dico)
+	if len(words) == 0 {
+		return nil, ErrEmptyDictionary
+	}
+	if seed == 0 {
+		seed = NewSeed()
+	}
+	return &WordPicker{
+		words:    words,
+		random:   rand.New(rand.NewSource(seed)),
+		noRepeat: noRepeat,
+	}, nil
+}
+
+// Return the next word of the session
+func (picker *WordPicker) Pick() string {
+	if !picker.noRepeat {
+		return picker.words[picker.random.Intn(len(picker.words))]
+	}
+	if picker.next >= len(picker.order) { // Every word was given, shuffle the dictionary again
+		picker.order = picker.random.Perm(len(picker.words))
+		picker.next = 0
+	}
+	word := picker.words[picker.order[picker.next]]
+	picker.next++
+	return word
+}
+
+// Return the number of words that can still be given before one comes back (always the whole dictionary without no-repeat)
+func (picker *WordPicker) Remaining() int {
+	if !picker.noRepeat || picker.next >= len(picker.order) {
+		return len(picker.words)
+	}
+	return len(picker.order) - picker.next
+}
+
+// What is written by Save, for the next launch of the program
+type pickerState struct {
+	Words string `json:"words"` // Fingerprint of the words, a new order is drawn if the dictionary changed
+	Order []int  `json:"order"` // Shuffled index of words
+	Next  int    `json:"next"`  // Position in Order of the next word
+}
+
+// Return the file of the no-repeat order of the dictionary, in SaveDir ("" for every dictionary)
+func PickerFile(dictionary string) string {
+	if dictionary == "" {
+		dictionary = "all"
+	}
+	return filepath.Join(SaveDir, ".words-"+filepath.Base(dictionary)+".json")
+}
+
+// Return a no-repeat picker for the dictionary that goes on with the order saved in fichier.
+// A new order is drawn from seed (0 picks a random one) if the file is missing or was written for other words.
+func LoadWordPicker(fichier string, dico []string, seed int64) (*WordPicker, error) {
+	picker, err := NewWordPicker(dico, seed, true)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(fichier)
+	if errors.Is(err, os.ErrNotExist) {
+		return picker, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var state pickerState
+	if err := json.Unmarshal(content, &state); err != nil || state.Words != picker.fingerprint() || !picker.validOrder(state.Order) {
+		return picker, nil // An order that can't be used is drawn again
+	}
+	picker.order, picker.next = state.Order, min(max(state.Next, 0), len(state.Order))
+	return picker, nil
+}
+
+// Save writes the no-repeat order in fichier, LoadWordPicker goes on from it
+func (picker *WordPicker) Save(fichier string) error {
+	if err := os.MkdirAll(filepath.Dir(fichier), 0o755); err != nil {
+		return err
+	}
+	state := pickerState{Words: picker.fingerprint(), Order: picker.order, Next: picker.next}
+	return writeFileAtomic(fichier, func(file io.Writer) error {
+		return json.NewEncoder(file).Encode(state)
+	})
+}
+
+// Return the fingerprint of the words of the picker
+func (picker *WordPicker) fingerprint() string {
+	sum := sha256.Sum256([]byte(strings.Join(picker.words, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// Return true if the order gives each word of the picker once
+func (picker *WordPicker) validOrder(order []int) bool {
+	if len(order) != len(picker.words) {
+		return false
+	}
+	seen := make([]bool, len(order))
+	for _, index := range order {
+		if index < 0 || index >= len(order) || seen[index] {
+			return false
+		}
+		seen[index] = true
+	}
+	return true
+}
+
+// Set Word and ToFind for HangManData with the next word of the picker
+func (hang *HangManData) SetWordFrom(picker *WordPicker) {
+	hang.SetToFind(picker.Pick())
+}
+
+// Return the words of the dictionary without the spaces around them and without the empty lines
+func CleanDico(dico []string) []string {
+	var words []string
+	for _, word := range dico {
+		word = strings.TrimSpace(word)
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+	return words
+}
@@ -0,0 +1,120 @@
+package hangman
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+var testDico = []string{"alpha", " bravo ", "", "charlie", "delta", "echo"}
+
+func TestWordPicker(t *testing.T) {
+	if _, err := NewWordPicker([]string{"", "  "}, 1, false); !errors.Is(err, ErrEmptyDictionary) {
+		t.Errorf("NewWordPicker of an empty dictionary = %v, expected ErrEmptyDictionary", err)
+	}
+
+	for _, noRepeat := range []bool{false, true} {
+		first, err := NewWordPicker(testDico, 42, noRepeat)
+		if err != nil {
+			t.Fatal(err)
+		}
+		second, _ := NewWordPicker(testDico, 42, noRepeat)
+		for i := 0; i < 12; i++ {
+			if a, b := first.Pick(), second.Pick(); a != b || !slices.Contains(CleanDico(testDico), a) {
+				t.Fatalf("Pick with the same seed gave %q and %q", a, b)
+			}
+		}
+	}
+
+	picker, _ := NewWordPicker(testDico, 7, true)
+	for round := 0; round < 3; round++ { // Every word once per round
+		var words []string
+		for i := 0; i < 5; i++ {
+			if remaining := picker.Remaining(); remaining != 5-i {
+				t.Errorf("Remaining = %d, expected %d", remaining, 5-i)
+			}
+			words = append(words, picker.Pick())
+		}
+		slices.Sort(words)
+		if expected := []string{"alpha", "bravo", "charlie", "delta", "echo"}; !slices.Equal(words, expected) {
+			t.Errorf("round %d gave %v, expected every word once", round, words)
+		}
+	}
+}
+
+func TestLoadWordPicker(t *testing.T) {
+	fichier := filepath.Join(t.TempDir(), "saves", ".words.json")
+	picker, err := LoadWordPicker(fichier, testDico, 3) // No file yet
+	if err != nil {
+		t.Fatalf("LoadWordPicker = %v", err)
+	}
+	given := []string{picker.Pick(), picker.Pick()}
+	if err := picker.Save(fichier); err != nil {
+		t.Fatalf("Save = %v", err)
+	}
+
+	next, err := LoadWordPicker(fichier, testDico, 0) // The seed doesn't matter, the order is read
+	if err != nil {
+		t.Fatalf("LoadWordPicker = %v", err)
+	}
+	if next.Remaining() != 3 {
+		t.Errorf("Remaining after loading = %d, expected 3", next.Remaining())
+	}
+	for i := 0; i < 3; i++ {
+		if word := next.Pick(); slices.Contains(given, word) {
+			t.Errorf("Pick gave %q again before the end of the dictionary", word)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		dico    []string
+		content string // Written in the file instead of the saved order, empty to keep it
+	}{
+		{name: "other dictionary", dico: []string{"alpha", "bravo", "charlie", "delta", "foxtrot"}},
+		{name: "not json", dico: testDico, content: "order"},
+		{name: "invalid order", dico: testDico, content: `{"words":"` + next.fingerprint() + `","order":[0,0,1,2,3],"next":1}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.content != "" {
+				if err := os.WriteFile(fichier, []byte(test.content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			picker, err := LoadWordPicker(fichier, test.dico, 5)
+			if err != nil {
+				t.Fatalf("LoadWordPicker = %v", err)
+			}
+			if picker.Remaining() != 5 {
+				t.Errorf("Remaining = %d, expected a new order of 5 words", picker.Remaining())
+			}
+		})
+	}
+}
+
+func TestSetWord(t *testing.T) {
+	for seed := int64(1); seed <= 20; seed++ {
+		var first, second HangManData
+		for _, hang := range []*HangManData{&first, &second} {
+			hang.SetData(NormalRules)
+			hang.Seed = seed
+			if err := hang.SetWord(testDico); err != nil {
+				t.Fatalf("SetWord = %v", err)
+			}
+		}
+		if first.ToFind != second.ToFind || string(first.Word) != string(second.Word) {
+			t.Errorf("SetWord with the seed %d gave %q and %q", seed, first.ToFind, second.ToFind)
+		}
+	}
+	var hang HangManData
+	hang.SetData(NormalRules)
+	if err := hang.SetWord(nil); !errors.Is(err, ErrEmptyDictionary) {
+		t.Errorf("SetWord of an empty dictionary = %v, expected ErrEmptyDictionary", err)
+	}
+	if hang.SetWord(testDico); hang.Seed == 0 {
+		t.Errorf("SetWord without seed kept the seed 0")
+	}
+}
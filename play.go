@@ -0,0 +1,93 @@
+package hangman
+
+import (
+	"errors"
+	"io"
+)
+
+// Renderer shows the game to the player. The termbox, classic and ascii modes are renderers,
+// another front end only has to implement it to be driven by Play.
+type Renderer interface {
+	Start(hang *HangManData) error                           // Shows the game before the first input
+	Guess(hang *HangManData, result GuessResult) error       // Shows an accepted guess
+	Reject(hang *HangManData, input string, err error) error // Shows an input refused by Guess (empty, already proposed...)
+	Message(text string)                                     // Shows an information, such as where the game was saved
+	End(hang *HangManData) error                             // Announces the result once the game is over
+}
+
+// A renderer that is also a SaveListener is told where the game was saved by STOP, instead of receiving a message
+type SaveListener interface {
+	Saved(hang *HangManData, slot string) error
+}
+
+// InputSource gives the inputs of the player, one by one. io.EOF is returned once there is no more input.
+type InputSource interface {
+	Next() (string, error)
+}
+
+// Play is the game loop: the inputs of source are played until the game is over or there is no more input.
+// Every step is shown with renderer, the journal is written after each accepted guess and the game is recorded in its history once over.
+// Once over, the slot the game was loaded from (LoadedSlot) is deleted: a save is played only once.
+// Every input is a guess, the front end decides how the player leaves or saves the game (see PlayTerminal).
+func (hang *HangManData) Play(renderer Renderer, source InputSource) error {
+	return hang.play(renderer, source, false)
+}
+
+// PlayTerminal is Play with the commands of the terminal modes: STOP saves the game in its slot of SaveDir and leaves it, QUIT leaves it
+func (hang *HangManData) PlayTerminal(renderer Renderer, source InputSource) error {
+	return hang.play(renderer, source, true)
+}
+
+// Game loop of Play, commands is true to handle STOP and QUIT
+func (hang *HangManData) play(renderer Renderer, source InputSource, commands bool) error {
+	if err := renderer.Start(hang); err != nil {
+		return err
+	}
+
+	for hang.Status() == InProgress { // Game loop
+		input, err := source.Next()
+		if errors.Is(err, io.EOF) { // The game is left as with QUIT
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if commands {
+			if stop, err := hang.stopCommand(input); stop {
+				if err != nil || input != "STOP" {
+					return err
+				}
+				if listener, ok := renderer.(SaveListener); ok {
+					return listener.Saved(hang, hang.slot())
+				}
+				renderer.Message(T("message.saved", hang.slot()))
+				return nil
+			}
+		}
+
+		// Verify input
+		result, err := hang.Guess(input)
+		if err != nil {
+			if err := renderer.Reject(hang, input, err); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := hang.writeJournal(); err != nil {
+			renderer.Message(err.Error())
+		}
+		if err := renderer.Guess(hang, result); err != nil {
+			return err
+		}
+	}
+
+	if err := hang.recordGame(); err != nil {
+		renderer.Message(err.Error())
+	}
+	if err := hang.clearSlot(); err != nil {
+		renderer.Message(err.Error())
+	}
+
+	// Announcement of results
+	return renderer.End(hang)
+}
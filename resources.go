@@ -0,0 +1,155 @@
+package hangman
+
+import (
+	"bufio"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Default resources, built into the program so that it works from any directory
+//
+//go:embed Ressources/Ascii_Letter Ressources/HangMan_Position Ressources/Dictionary Ressources/Locale Ressources/rules.txt
+var embedded embed.FS
+
+// Directory of the resources on disk, its files replace the built-in ones
+const ResourceDir = "Ressources"
+
+var resources = defaultResources() // File system the resources are read from
+
+// Return the built-in resources, with the files of ResourceDir (if there is one) on top of them
+func defaultResources() fs.FS {
+	builtIn, err := fs.Sub(embedded, ResourceDir)
+	if err != nil {
+		panic(err) // The directory is embedded, it can't be missing
+	}
+	return Overlay(os.DirFS(ResourceDir), builtIn)
+}
+
+// Return the built-in resources alone
+func EmbeddedResources() fs.FS {
+	builtIn, _ := fs.Sub(embedded, ResourceDir)
+	return builtIn
+}
+
+// Return the file system the resources are read from, paths are relative to it (ex: "Dictionary/words.txt")
+func Resources() fs.FS {
+	return resources
+}
+
+// Set the file system the resources are read from, the files already read by DefaultAssets are forgotten
+func SetResources(fsys fs.FS) {
+	resources = fsys
+	DefaultAssets.Reload()
+}
+
+// Read the resources from dir, the files that are not in dir are read from the built-in ones
+func SetResourceDir(dir string) {
+	SetResources(Overlay(os.DirFS(dir), EmbeddedResources()))
+}
+
+// OverlayFS reads each file from the first layer that has it, the directories show the files of every layer
+type OverlayFS struct {
+	layers []fs.FS
+}
+
+// Return the layers as a single file system, the first layer is on top
+func Overlay(layers ...fs.FS) *OverlayFS {
+	return &OverlayFS{layers: layers}
+}
+
+// Open the file from the first layer that has it
+func (overlay *OverlayFS) Open(name string) (fs.File, error) {
+	err := error(&fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist})
+	for _, layer := range overlay.layers {
+		file, openErr := layer.Open(name)
+		if openErr == nil {
+			return file, nil
+		}
+		if !errors.Is(openErr, fs.ErrNotExist) { // A file that exists but can't be read is not hidden
+			return nil, openErr
+		}
+		err = openErr
+	}
+	return nil, err
+}
+
+// Return the entries of the directory in every layer, an entry of an upper layer hides the ones with the same name
+func (overlay *OverlayFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	seen := map[string]bool{}
+	var entries []fs.DirEntry
+	found := false
+	for _, layer := range overlay.layers {
+		layerEntries, err := fs.ReadDir(layer, name)
+		if err != nil {
+			continue
+		}
+		found = true
+		for _, e := range layerEntries {
+			if !seen[e.Name()] {
+				seen[e.Name()] = true
+				entries = append(entries, e)
+			}
+		}
+	}
+	if !found {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return entries, nil
+}
+
+// ResourceError tells which resource is wrong, and where
+type ResourceError struct {
+	File string // Path of the resource
+	Line int    // Line of the error, 0 if it is about the whole file
+	Err  error
+}
+
+func (err *ResourceError) Error() string {
+	if err.Line > 0 {
+		return fmt.Sprintf("%s:%d: %v", err.File, err.Line, err.Err)
+	}
+	return fmt.Sprintf("%s: %v", err.File, err.Err)
+}
+
+func (err *ResourceError) Unwrap() error {
+	return err.Err
+}
+
+// A line "key = value" of a settings file, a language pack or a theme
+type keyValue struct {
+	Key   string // Without the spaces around it
+	Value string // Without the spaces around it
+	Line  int    // Line of the file, from 1
+}
+
+// This function reads the lines "key = value" of the file, the empty lines and the lines starting with '#' are left out.
+// A line without '=' is a ResourceError, the keys are checked by the callers.
+func readKeyValues(file io.Reader, fichier string) ([]keyValue, error) {
+	var entries []keyValue
+	fileScanner := bufio.NewScanner(file)
+	line := 1
+	for ; fileScanner.Scan(); line++ {
+		text := strings.TrimSpace(fileScanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, &ResourceError{File: fichier, Line: line, Err: localErrorf("resource.notKeyValue", text)}
+		}
+		entries = append(entries, keyValue{Key: strings.TrimSpace(key), Value: strings.TrimSpace(value), Line: line})
+	}
+	if err := fileScanner.Err(); err != nil {
+		return nil, &ResourceError{File: fichier, Line: line, Err: err}
+	}
+	return entries, nil
+}
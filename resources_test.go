@@ -0,0 +1,138 @@
+package hangman
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// Read the resources of the test from files, the resources of the program are given back at the end
+func setTestResources(t *testing.T, files fs.FS) {
+	t.Helper()
+	previous := Resources()
+	SetResources(files)
+	t.Cleanup(func() { SetResources(previous) })
+}
+
+// Check that err is a ResourceError at the line, a line below 0 expects no error
+func checkResourceError(t *testing.T, err error, line int) {
+	t.Helper()
+	if line < 0 {
+		if err != nil {
+			t.Fatalf("read = %v, expected no error", err)
+		}
+		return
+	}
+	var resourceErr *ResourceError
+	if !errors.As(err, &resourceErr) {
+		t.Fatalf("read = %v, expected a ResourceError", err)
+	}
+	if resourceErr.Line != line {
+		t.Errorf("read = %v, expected the line %d", err, line)
+	}
+}
+
+func TestOverlayFS(t *testing.T) {
+	upper := fstest.MapFS{
+		"Dictionary/words.txt": {Data: []byte("upper\n")},
+		"Dictionary/mine.txt":  {Data: []byte("mine\n")},
+	}
+	lower := fstest.MapFS{
+		"Dictionary/words.txt": {Data: []byte("lower\n")},
+		"Dictionary/other.txt": {Data: []byte("other\n")},
+		"rules.txt":            {Data: []byte("rules\n")},
+	}
+	overlay := Overlay(upper, lower)
+
+	files := []struct {
+		name    string
+		content string
+	}{
+		{"Dictionary/words.txt", "upper\n"},
+		{"Dictionary/mine.txt", "mine\n"},
+		{"Dictionary/other.txt", "other\n"},
+		{"rules.txt", "rules\n"},
+	}
+	for _, file := range files {
+		content, err := fs.ReadFile(overlay, file.name)
+		if err != nil || string(content) != file.content {
+			t.Errorf("ReadFile(%q) = %q, %v, expected %q", file.name, content, err, file.content)
+		}
+	}
+	if _, err := overlay.Open("Dictionary/missing.txt"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Open of a missing file = %v, expected fs.ErrNotExist", err)
+	}
+
+	entries, err := fs.ReadDir(overlay, "Dictionary")
+	if err != nil {
+		t.Fatalf("ReadDir = %v", err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	if expected := []string{"mine.txt", "other.txt", "words.txt"}; !slices.Equal(names, expected) {
+		t.Errorf("ReadDir = %v, expected %v", names, expected)
+	}
+	if _, err := fs.ReadDir(overlay, "Missing"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ReadDir of a missing directory = %v, expected fs.ErrNotExist", err)
+	}
+}
+
+func TestSetResourceDir(t *testing.T) {
+	previous := Resources()
+	t.Cleanup(func() { SetResources(previous) })
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "Dictionary"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "Dictionary", "mine.txt"), []byte("mine\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	SetResourceDir(dir)
+
+	if words, err := ReadFile("Dictionary/mine.txt"); err != nil || !slices.Equal(words, []string{"mine"}) {
+		t.Errorf("ReadFile of the directory = %v, %v", words, err)
+	}
+	if _, err := ReadFile("Dictionary/words.txt"); err != nil { // Built in
+		t.Errorf("ReadFile of a built-in file = %v", err)
+	}
+	names, err := ListDictio()
+	if err != nil || !slices.Contains(names, "mine.txt") || !slices.Contains(names, "words.txt") {
+		t.Errorf("ListDictio = %v, %v, expected the files of both layers", names, err)
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	setTestResources(t, fstest.MapFS{"Dictionary/words.txt": {Data: []byte("a\n\nb")}})
+	if words, err := ReadFile("Dictionary/words.txt"); err != nil || !slices.Equal(words, []string{"a", "", "b"}) {
+		t.Errorf("ReadFile = %q, %v", words, err)
+	}
+	_, err := ReadFile("Dictionary/missing.txt")
+	checkResourceError(t, err, 0)
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ReadFile of a missing file = %v, expected fs.ErrNotExist", err)
+	}
+}
+
+func TestReadKeyValues(t *testing.T) {
+	entries, err := readKeyValues(strings.NewReader("# comment\n\n  Theme = light  \nempty =\n  # indented comment\na = b = c\n"), "test.txt")
+	if err != nil {
+		t.Fatalf("readKeyValues = %v", err)
+	}
+	expected := []keyValue{{"Theme", "light", 3}, {"empty", "", 4}, {"a", "b = c", 6}}
+	if !slices.Equal(entries, expected) {
+		t.Errorf("readKeyValues = %+v, expected %+v", entries, expected)
+	}
+
+	_, err = readKeyValues(strings.NewReader("a = b\n\nnot a pair\n"), "test.txt")
+	checkResourceError(t, err, 3)
+	if !strings.HasPrefix(err.Error(), "test.txt:3: ") {
+		t.Errorf("readKeyValues = %v, expected the file and the line in the message", err)
+	}
+}
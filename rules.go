@@ -0,0 +1,46 @@
+package hangman
+
+import "strings"
+
+// Rules of a game, they are given when the game is created (see SetData)
+type Rules struct {
+	Attempts     int     // Number of attempts at the beginning of the game
+	LetterCost   int     // Attempts lost for a wrong letter
+	WordCost     int     // Attempts lost for a wrong word
+	Reveal       int     // Number of letters revealed at the beginning, added to the ones given by RevealRatio
+	RevealRatio  float64 // Part of the word revealed at the beginning, 0.5 reveals half of the letters
+	RevealedUsed bool    // If true, the revealed letters are added to ListLetter (a letter already shown can't be proposed in any case)
+}
+
+// Presets of the game, NormalRules is the classic hangman
+var (
+	EasyRules   = Rules{Attempts: 12, LetterCost: 1, WordCost: 1, RevealRatio: 0.5, RevealedUsed: true}
+	NormalRules = Rules{Attempts: 10, LetterCost: 1, WordCost: 2, Reveal: -1, RevealRatio: 0.5, RevealedUsed: true}
+	HardRules   = Rules{Attempts: 6, LetterCost: 1, WordCost: 3, Reveal: 1}
+)
+
+// Return the preset corresponding to the given name (easy, normal or hard), normal if the name is empty
+func RulesFor(name string) (Rules, error) {
+	switch strings.ToLower(name) {
+	case "easy":
+		return EasyRules, nil
+	case "normal", "":
+		return NormalRules, nil
+	case "hard":
+		return HardRules, nil
+	}
+	return Rules{}, ErrUnknownRules
+}
+
+// Return the number of letters to reveal at the beginning for a word of the given number of different letters.
+// Each letter is revealed at all its positions, at least one letter is always left to find.
+func (rules Rules) RevealCount(length int) int {
+	count := rules.Reveal + int(float64(length)*rules.RevealRatio)
+	if count > length-1 {
+		count = length - 1
+	}
+	if count < 0 {
+		count = 0
+	}
+	return count
+}
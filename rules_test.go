@@ -0,0 +1,81 @@
+package hangman
+
+import "testing"
+
+func TestRevealCount(t *testing.T) {
+	tests := []struct {
+		rules    Rules
+		letters  int
+		expected int
+	}{
+		{EasyRules, 1, 0},
+		{EasyRules, 4, 2},
+		{EasyRules, 7, 3},
+		{NormalRules, 2, 0},
+		{NormalRules, 4, 1},
+		{NormalRules, 10, 4},
+		{HardRules, 1, 0},
+		{HardRules, 2, 1},
+		{HardRules, 10, 1},
+		{Rules{Reveal: 20}, 5, 4},
+	}
+	for _, test := range tests {
+		if count := test.rules.RevealCount(test.letters); count != test.expected {
+			t.Errorf("%+v.RevealCount(%d) = %d, expected %d", test.rules, test.letters, count, test.expected)
+		}
+	}
+}
+
+func TestSetToFind(t *testing.T) {
+	tests := []struct {
+		word    string
+		rules   Rules
+		letters int // Distinct letters revealed
+	}{
+		{"banana", EasyRules, 1},
+		{"banana", HardRules, 1},
+		{"mississippi", NormalRules, 1},
+		{"abcdefgh", EasyRules, 4},
+		{"ice cream", NormalRules, 2},
+		{"a", EasyRules, 0},
+	}
+	for _, test := range tests {
+		for seed := int64(1); seed <= 20; seed++ {
+			var first, second HangManData
+			for _, hang := range []*HangManData{&first, &second} {
+				hang.SetData(test.rules)
+				hang.Seed = seed
+				hang.SetToFind(test.word)
+			}
+			if string(first.Word) != string(second.Word) || string(first.ListLetter) != string(second.ListLetter) {
+				t.Fatalf("SetToFind(%q) with the seed %d gave %q and %q", test.word, seed, string(first.Word), string(second.Word))
+			}
+
+			revealed := map[rune]bool{}
+			for i, letter := range first.Word {
+				if letter == '_' {
+					continue
+				}
+				if letter != []rune(test.word)[i] {
+					t.Fatalf("SetToFind(%q) shows %q", test.word, string(first.Word))
+				}
+				if IsGuessable(letter) {
+					revealed[letter] = true
+				}
+			}
+			if len(revealed) != test.letters {
+				t.Errorf("SetToFind(%q) with the seed %d revealed %q, expected %d letters", test.word, seed, string(first.Word), test.letters)
+			}
+			if first.Status() != InProgress {
+				t.Errorf("SetToFind(%q) with the seed %d revealed the whole word", test.word, seed)
+			}
+			for letter := range revealed { // Every position of a revealed letter is shown
+				for i, char := range test.word {
+					if char == letter && first.Word[i] != letter {
+						t.Errorf("SetToFind(%q) shows %q, a copy of %c is hidden", test.word, string(first.Word), letter)
+					}
+				}
+			}
+		}
+	}
+}
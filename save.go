@@ -0,0 +1,203 @@
+package hangman
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Version of the save format written by Save.
+// Version 0 is the first format: the HangManData structure alone, without envelope.
+// Since version 2 the word is encrypted and the envelope is signed with the local key (see KeyFile).
+const SaveVersion = 2
+
+// SaveEnvelope is what is written in a save file: the game and what is needed to read it back
+type SaveEnvelope struct {
+	Version    int             `json:"version"`    // Version of the save format
+	Created    time.Time       `json:"created"`    // Date of the save
+	Dictionary string          `json:"dictionary"` // Name of the dictionary the word comes from
+	Mode       string          `json:"mode"`       // Game mode (termbox, classic, ascii, accessible, batch or json)
+	Secret     string          `json:"secret"`     // Word to find and seed, encrypted with the local key (since version 2)
+	Game       json.RawMessage `json:"game"`       // HangManData without the word, in the format of Version
+	MAC        string          `json:"mac"`        // Signature of the other fields with the local key (since version 2)
+}
+
+// What is encrypted in Secret: the word, and the seed that would give the word back with the dictionary
+type saveSecret struct {
+	ToFind string `json:"word"`
+	Seed   int64  `json:"seed"`
+}
+
+// Migrations of the game data, migrations[n] turns the data of version n into version n+1
+var migrations = []func(game map[string]any){
+	// 0 -> 1: the rules didn't exist, every game was a normal game
+	func(game map[string]any) {
+		if _, ok := game["Rules"]; !ok {
+			game["Rules"] = NormalRules
+		}
+	},
+	// 1 -> 2: the word and the seed moved to Secret, an older game still has them in the game
+	func(game map[string]any) {},
+}
+
+// Saves the party's progress, which is stored in the HangManData structure.
+// The word is encrypted and the save is signed, so that it can't be read or edited.
+func (data HangManData) Save(filename string) error {
+	key, err := SaveKey()
+	if err != nil {
+		return err
+	}
+	hidden, err := json.Marshal(saveSecret{ToFind: data.ToFind, Seed: data.Seed})
+	if err != nil {
+		return err
+	}
+	secret, err := sealSecret(key, hidden)
+	if err != nil {
+		return err
+	}
+	data.ToFind, data.Seed = "", 0 // They are only written encrypted
+	game, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	envelope := SaveEnvelope{
+		Version:    SaveVersion,
+		Created:    time.Now().UTC(),
+		Dictionary: data.Dictionary,
+		Mode:       data.Mode,
+		Secret:     secret,
+		Game:       game,
+	}
+	envelope.MAC = envelope.signature(key)
+
+	return writeFileAtomic(filename, func(file io.Writer) error {
+		encoder := json.NewEncoder(file)
+		return encoder.Encode(envelope) // Save HangManData in its envelope
+	})
+}
+
+// Writes a file through a temporary file renamed at the end, so that a crash never leaves half a file
+func writeFileAtomic(filename string, write func(file io.Writer) error) error {
+	file, err := os.CreateTemp(filepath.Dir(filename), "."+filepath.Base(filename)+".tmp*") // Create a file next to the real one
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name()) // Does nothing once the file is renamed
+
+	if err := write(file); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), filename)
+}
+
+// Load the party's progress, which is stored in filename, return a HangManData struct.
+// Only signed saves are loaded: edited saves, saves of newer versions and unsigned saves of older versions are rejected
+// (see LoadUnverified to migrate an older save).
+func Load(filename string) (HangManData, error) {
+	return load(filename, false)
+}
+
+// Load the party's progress like Load, the unsigned saves of older versions are migrated instead of being rejected.
+// Nothing proves that such a save wasn't edited: it must only be used on purpose, to import an old save (see SlotManager.Import).
+func LoadUnverified(filename string) (HangManData, error) {
+	return load(filename, true)
+}
+
+// Load the save, unsigned is true to accept the unsigned saves of older versions
+func load(filename string, unsigned bool) (HangManData, error) {
+	var data HangManData
+
+	envelope, err := ReadEnvelope(filename)
+	if err != nil {
+		return data, err
+	}
+	if envelope.Version < 2 && !unsigned { // Without signature, the game could have been written by hand
+		return data, fmt.Errorf("%s: %w", filename, ErrSaveTampered)
+	}
+	var secret saveSecret
+	if envelope.Version >= 2 {
+		key, err := SaveKey()
+		if err != nil {
+			return data, err
+		}
+		if !envelope.verify(key) {
+			return data, fmt.Errorf("%s: %w", filename, ErrSaveTampered)
+		}
+		hidden, err := openSecret(key, envelope.Secret)
+		if err != nil {
+			return data, fmt.Errorf("%s: %w", filename, err)
+		}
+		if err := json.Unmarshal(hidden, &secret); err != nil {
+			return data, fmt.Errorf("%s: %w", filename, ErrInvalidSave)
+		}
+	}
+
+	var game map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(envelope.Game))
+	decoder.UseNumber() // Keep the seed exact, a float64 can't hold every int64
+	if err := decoder.Decode(&game); err != nil {
+		return data, err
+	}
+	for version := envelope.Version; version < SaveVersion; version++ { // Migrate the game to the current version
+		migrations[version](game)
+	}
+	migrated, err := json.Marshal(game)
+	if err != nil {
+		return data, err
+	}
+	if err := json.Unmarshal(migrated, &data); err != nil { // Load HangManData
+		return data, err
+	}
+	data.Dictionary = envelope.Dictionary
+	data.Mode = envelope.Mode
+	if envelope.Version >= 2 {
+		data.ToFind, data.Seed = secret.ToFind, secret.Seed
+	}
+
+	return data, nil
+}
+
+// Read the envelope of a save file without loading the game, a save of version 0 is put in an envelope
+func ReadEnvelope(filename string) (SaveEnvelope, error) {
+	var envelope SaveEnvelope
+
+	content, err := os.ReadFile(filename) // Open filename
+	if err != nil {
+		return envelope, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(content, &fields); err != nil {
+		return envelope, fmt.Errorf("%s: %w", filename, err)
+	}
+	if _, ok := fields["version"]; !ok { // Version 0, the file is the game itself
+		envelope.Game = content
+		if info, err := os.Stat(filename); err == nil {
+			envelope.Created = info.ModTime()
+		}
+		return envelope, nil
+	}
+
+	if err := json.Unmarshal(content, &envelope); err != nil {
+		return envelope, fmt.Errorf("%s: %w", filename, err)
+	}
+	if envelope.Version > SaveVersion {
+		return envelope, fmt.Errorf("%s: %w (%s)", filename, ErrSaveTooNew, T("error.saveVersions", envelope.Version, SaveVersion))
+	}
+	if envelope.Version < 0 || envelope.Game == nil {
+		return envelope, fmt.Errorf("%s: %w", filename, ErrInvalidSave)
+	}
+	return envelope, nil
+}
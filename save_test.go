@@ -0,0 +1,216 @@
+package hangman
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Set a key for the saves of the test, KeyFile is never written
+func setTestKey(t *testing.T) {
+	t.Helper()
+	previous := saveKey
+	SetSaveKey([]byte("0123456789abcdef0123456789abcdef"))
+	t.Cleanup(func() { SetSaveKey(previous) })
+}
+
+func TestSaveRoundTrip(t *testing.T) {
+	setTestKey(t)
+	hang := newTestGame("ice cream", HardRules)
+	hang.Seed = -1 << 62 // Kept exact, a float64 would round it
+	hang.Dictionary, hang.Mode = "words.txt", "batch"
+	for _, input := range []string{"c", "z", "ice creme"} {
+		if _, err := hang.Guess(input); err != nil {
+			t.Fatalf("Guess(%q) = %v", input, err)
+		}
+	}
+
+	filename := filepath.Join(t.TempDir(), "save.txt")
+	if err := hang.Save(filename); err != nil {
+		t.Fatalf("Save = %v", err)
+	}
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(content), "cream") {
+		t.Errorf("the save shows the word: %s", content)
+	}
+	loaded, err := Load(filename)
+	if err != nil {
+		t.Fatalf("Load = %v", err)
+	}
+	if !reflect.DeepEqual(loaded, *hang) {
+		t.Errorf("Load = %+v, expected %+v", loaded, *hang)
+	}
+}
+
+func TestLoadMigration(t *testing.T) {
+	setTestKey(t)
+	tests := []struct {
+		name    string
+		content string
+		rules   Rules
+	}{
+		{name: "version 0", rules: NormalRules,
+			content: `{"Word":[104,95,108,108,95],"ToFind":"hello","Attempts":7,"HangmanPositions":2,"ListWord":[],"ListLetter":[104,108]}`},
+		{name: "version 1", rules: HardRules,
+			content: `{"version":1,"dictionary":"words.txt","mode":"classic","game":{"Word":[104,95,108,108,95],"ToFind":"hello","Attempts":7,"HangmanPositions":2,"ListWord":[],"ListLetter":[104,108],"Rules":` + mustJSON(t, HardRules) + `}}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "old.txt")
+			if err := os.WriteFile(filename, []byte(test.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Load(filename); !errors.Is(err, ErrSaveTampered) {
+				t.Fatalf("Load of an unsigned save = %v, expected ErrSaveTampered", err)
+			}
+			loaded, err := LoadUnverified(filename)
+			if err != nil {
+				t.Fatalf("LoadUnverified = %v", err)
+			}
+			if loaded.ToFind != "hello" || string(loaded.Word) != "h_ll_" || loaded.Attempts != 7 || loaded.Rules != test.rules {
+				t.Errorf("LoadUnverified = %+v", loaded)
+			}
+
+			slots := NewSlotManager(t.TempDir())
+			if err := slots.Import("old", filename); err != nil {
+				t.Fatalf("Import = %v", err)
+			}
+			imported, err := slots.Load("old")
+			if err != nil {
+				t.Fatalf("Load of the imported save = %v", err)
+			}
+			if !reflect.DeepEqual(imported, loaded) {
+				t.Errorf("imported save = %+v, expected %+v", imported, loaded)
+			}
+		})
+	}
+}
+
+func TestLoadRejected(t *testing.T) {
+	setTestKey(t)
+	hang := newTestGame("hello", NormalRules)
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "save.txt")
+	if err := hang.Save(filename); err != nil {
+		t.Fatalf("Save = %v", err)
+	}
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		edit func(envelope map[string]json.RawMessage)
+		err  error
+	}{
+		{name: "unchanged", edit: func(envelope map[string]json.RawMessage) {}},
+		{name: "attempts", err: ErrSaveTampered, edit: func(envelope map[string]json.RawMessage) {
+			envelope["game"] = json.RawMessage(strings.Replace(string(envelope["game"]), `"Attempts":10`, `"Attempts":99`, 1))
+		}},
+		{name: "dictionary", err: ErrSaveTampered, edit: func(envelope map[string]json.RawMessage) { envelope["dictionary"] = json.RawMessage(`"other.txt"`) }},
+		{name: "signature", err: ErrSaveTampered, edit: func(envelope map[string]json.RawMessage) {
+			envelope["mac"] = json.RawMessage(`"` + strings.Repeat("00", 32) + `"`)
+		}},
+		{name: "secret", err: ErrSaveTampered, edit: func(envelope map[string]json.RawMessage) { envelope["secret"] = json.RawMessage(`"AAAA"`) }},
+		{name: "version 1", err: ErrSaveTampered, edit: func(envelope map[string]json.RawMessage) { envelope["version"] = json.RawMessage(`1`) }},
+		{name: "newer version", err: ErrSaveTooNew, edit: func(envelope map[string]json.RawMessage) {
+			envelope["version"] = json.RawMessage(mustJSON(t, SaveVersion+1))
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var envelope map[string]json.RawMessage
+			if err := json.Unmarshal(content, &envelope); err != nil {
+				t.Fatal(err)
+			}
+			test.edit(envelope)
+			edited := filepath.Join(dir, test.name+".txt")
+			if err := os.WriteFile(edited, []byte(mustJSON(t, envelope)), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Load(edited); !errors.Is(err, test.err) {
+				t.Errorf("Load = %v, expected %v", err, test.err)
+			}
+		})
+	}
+
+	t.Run("other key", func(t *testing.T) {
+		SetSaveKey([]byte("another key of the saves, 32 byte"))
+		if _, err := Load(filename); !errors.Is(err, ErrSaveTampered) {
+			t.Errorf("Load with another key = %v, expected ErrSaveTampered", err)
+		}
+	})
+}
+
+// Use a key file of the test, it is created by the first save
+func setTestKeyFile(t *testing.T) {
+	t.Helper()
+	previousFile, previousKey := KeyFile, saveKey
+	KeyFile = filepath.Join(t.TempDir(), "hangman", "save.key")
+	SetSaveKey(nil)
+	t.Cleanup(func() {
+		KeyFile = previousFile
+		SetSaveKey(previousKey)
+	})
+}
+
+func TestConcurrentSaves(t *testing.T) {
+	setTestKeyFile(t)
+	dir := t.TempDir()
+	var group sync.WaitGroup
+	errs := make([]error, 8)
+	for i := range errs {
+		group.Add(1)
+		go func(i int) {
+			defer group.Done()
+			errs[i] = newTestGame("hello", NormalRules).Save(filepath.Join(dir, fmt.Sprintf("save%d.txt", i)))
+		}(i)
+	}
+	group.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("Save %d = %v", i, err)
+		}
+		if _, err := Load(filepath.Join(dir, fmt.Sprintf("save%d.txt", i))); err != nil {
+			t.Errorf("Load of the save %d = %v, every save must be signed with the same key", i, err)
+		}
+	}
+}
+
+func TestSaveKeyFile(t *testing.T) {
+	setTestKeyFile(t)
+	if err := os.MkdirAll(filepath.Dir(KeyFile), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(KeyFile, []byte("key written by another program"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := createKeyFile(); !errors.Is(err, os.ErrExist) {
+		t.Errorf("createKeyFile of an existing file = %v, expected os.ErrExist", err)
+	}
+	key, err := SaveKey()
+	if err != nil || string(key) != "key written by another program" {
+		t.Errorf("SaveKey = %q, %v, expected the key of the file", key, err)
+	}
+}
+
+// Return the value in JSON
+func mustJSON(t *testing.T, value any) string {
+	t.Helper()
+	content, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(content)
+}
@@ -0,0 +1,167 @@
+package hangman
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// File of the local key used to encrypt the word and sign the saves, created the first time a save is written
+var KeyFile = defaultKeyFile()
+
+var (
+	saveKey     []byte     // Key given with SetSaveKey or read from KeyFile
+	saveKeyLock sync.Mutex // The saves can be written by several goroutines at once (a server)
+)
+
+// Return the default place of the key: in the user's configuration directory, otherwise next to the saves
+func defaultKeyFile() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "Ressources/Save/.key"
+	}
+	return filepath.Join(dir, "hangman", "save.key")
+}
+
+// Set the key of the saves instead of using KeyFile (a server can keep its own key)
+func SetSaveKey(key []byte) {
+	saveKeyLock.Lock()
+	defer saveKeyLock.Unlock()
+	saveKey = key
+}
+
+// Return the key of the saves, KeyFile is created with a random key if it doesn't exist
+func SaveKey() ([]byte, error) {
+	saveKeyLock.Lock()
+	defer saveKeyLock.Unlock()
+	if saveKey != nil {
+		return saveKey, nil
+	}
+	key, err := os.ReadFile(KeyFile)
+	if errors.Is(err, os.ErrNotExist) {
+		key, err = createKeyFile()
+	}
+	if errors.Is(err, os.ErrExist) { // Created by another program since, the key may not be written yet
+		for try := 0; try < 10; try++ {
+			if key, err = os.ReadFile(KeyFile); err != nil || len(key) != 0 {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(key) == 0 {
+		return nil, ErrInvalidSave
+	}
+	saveKey = key
+	return key, nil
+}
+
+// Create KeyFile with a random key, it fails with os.ErrExist if another program created it first
+func createKeyFile() ([]byte, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(KeyFile), 0o700); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(KeyFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := file.Write(key); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return key, file.Close()
+}
+
+// Return a key for one use (encryption or signature), so that the same key is never used twice
+func deriveKey(key []byte, use string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(use))
+	return mac.Sum(nil)
+}
+
+// Encrypt the secret of a game, the result is written in the save instead of the word
+func sealSecret(key []byte, secret []byte) (string, error) {
+	block, err := aes.NewCipher(deriveKey(key, "secret"))
+	if err != nil {
+		return "", err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, secret, nil)), nil
+}
+
+// Decrypt the secret written by sealSecret
+func openSecret(key []byte, secret string) ([]byte, error) {
+	sealed, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, ErrSaveTampered
+	}
+	block, err := aes.NewCipher(deriveKey(key, "secret"))
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, ErrSaveTampered
+	}
+	opened, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, ErrSaveTampered
+	}
+	return opened, nil
+}
+
+// Return the signature of the envelope, every field except MAC is signed
+func (envelope SaveEnvelope) signature(key []byte) string {
+	mac := hmac.New(sha256.New, deriveKey(key, "signature"))
+	for _, field := range []string{
+		strconv.Itoa(envelope.Version),
+		envelope.Created.Format(time.RFC3339Nano),
+		envelope.Dictionary,
+		envelope.Mode,
+		envelope.Secret,
+		string(envelope.Game),
+	} {
+		mac.Write([]byte(strconv.Itoa(len(field)) + ":" + field)) // The length avoids moving text from a field to the next one
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Return true if the MAC of the envelope was written with the key
+func (envelope SaveEnvelope) verify(key []byte) bool {
+	expected, err := hex.DecodeString(envelope.signature(key))
+	if err != nil {
+		return false
+	}
+	given, err := hex.DecodeString(envelope.MAC)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(expected, given)
+}
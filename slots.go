@@ -0,0 +1,185 @@
+package hangman
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Directory of the save slots of the terminal modes, the saves are not resources: they are always on disk.
+// The journal (see JournalFile) and the history of the games (see StatsFile) are written there too.
+var SaveDir = defaultSaveDir()
+
+// Return the default directory of the saves: in the user's configuration directory, next to the key (see KeyFile)
+func defaultSaveDir() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "Ressources/Save"
+	}
+	return filepath.Join(dir, "hangman", "saves")
+}
+
+// Slot used when no player is given
+const DefaultSlot = "save"
+
+// Extension of the slot files, save.txt is the slot "save"
+const slotExtension = ".txt"
+
+// SlotManager keeps named saves in a directory, one file per slot
+type SlotManager struct {
+	Dir string // Directory of the slots
+}
+
+// Informations about a slot, to choose a game without revealing it
+type SlotInfo struct {
+	Name       string    // Name of the slot (player name or id)
+	WordLength int       // Number of runes of the word to find
+	Attempts   int       // Number of attempts left
+	Date       time.Time // Date of the save
+	Dictionary string    // Name of the dictionary the word comes from
+	Mode       string    // Game mode of the save
+	Err        error     // Not nil if the slot can't be loaded (edited, too new...), it can still be deleted
+}
+
+// Return a manager for the slots of the given directory
+func NewSlotManager(dir string) *SlotManager {
+	return &SlotManager{Dir: dir}
+}
+
+// Return the file of the slot, the name can't go out of the directory
+func (slots *SlotManager) path(name string) (string, error) {
+	name = strings.TrimSuffix(name, slotExtension)
+	if name == "" || strings.HasPrefix(name, ".") || strings.ContainsAny(name, `/\:`) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidSlot, name)
+	}
+	return filepath.Join(slots.Dir, name+slotExtension), nil
+}
+
+// Save the game in the slot, an existing slot is replaced
+func (slots *SlotManager) Save(name string, data HangManData) error {
+	path, err := slots.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(slots.Dir, 0o755); err != nil {
+		return err
+	}
+	return data.Save(path)
+}
+
+// Load the game of the slot
+func (slots *SlotManager) Load(name string) (HangManData, error) {
+	path, err := slots.path(name)
+	if err != nil {
+		return HangManData{}, err
+	}
+	return Load(path)
+}
+
+// Import the save of an older version in the slot, it is signed again so that Load accepts it.
+// The save isn't checked (see LoadUnverified): it must be a file the player trusts. An existing slot is never replaced.
+func (slots *SlotManager) Import(name, filename string) error {
+	path, err := slots.path(name)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("%w: %q", ErrSlotExists, strings.TrimSuffix(name, slotExtension))
+	}
+	data, err := LoadUnverified(filename)
+	if err != nil {
+		return err
+	}
+	return slots.Save(name, data)
+}
+
+// Delete the slot
+func (slots *SlotManager) Delete(name string) error {
+	path, err := slots.path(name)
+	if err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// Deletes the slot the game was loaded from, so that a game can't be played again from its save
+func (hang *HangManData) clearSlot() error {
+	if hang.LoadedSlot == "" {
+		return nil
+	}
+	if err := NewSlotManager(SaveDir).Delete(hang.LoadedSlot); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	hang.LoadedSlot = ""
+	return nil
+}
+
+// Return the slots of the directory, the most recent first. A missing directory has no slot.
+func (slots *SlotManager) List() ([]SlotInfo, error) {
+	entries, err := os.ReadDir(slots.Dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var list []SlotInfo
+	for _, e := range entries {
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") || !strings.HasSuffix(e.Name(), slotExtension) {
+			continue
+		}
+		info := SlotInfo{Name: strings.TrimSuffix(e.Name(), slotExtension)}
+		data, err := Load(filepath.Join(slots.Dir, e.Name()))
+		if err != nil {
+			info.Err = err
+		} else {
+			info.WordLength = utf8.RuneCountInString(data.ToFind)
+			info.Attempts = data.Attempts
+			info.Dictionary = data.Dictionary
+			info.Mode = data.Mode
+		}
+		if envelope, err := ReadEnvelope(filepath.Join(slots.Dir, e.Name())); err == nil {
+			info.Date = envelope.Created
+		}
+		list = append(list, info)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Date.After(list[j].Date)
+	})
+	return list, nil
+}
+
+// Displays the slots and asks the player which one to resume, return "" for a new game
+func (slots *SlotManager) Pick() (string, error) {
+	list, err := slots.List()
+	if err != nil || len(list) == 0 {
+		return "", err
+	}
+	fmt.Println(T("slots.title"))
+	for i, slot := range list {
+		if slot.Err != nil {
+			fmt.Println(T("slots.unreadable", i+1, slot.Name, slot.Err))
+			continue
+		}
+		fmt.Println(T("slots.entry", i+1, slot.Name, slot.WordLength, slot.Attempts, slot.Date.Local().Format("2006-01-02 15:04")))
+	}
+	for {
+		choice, err := ReadLine(T("slots.choose"))
+		if err != nil {
+			return "", err
+		}
+		if choice == "" {
+			return "", nil
+		}
+		var index int
+		if _, err := fmt.Sscan(choice, &index); err == nil && index >= 1 && index <= len(list) && list[index-1].Err == nil {
+			return list[index-1].Name, nil
+		}
+		fmt.Println(T("slots.invalid"))
+	}
+}
@@ -0,0 +1,208 @@
+package hangman
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// Keep the slots of the test in a temporary SaveDir
+func setTestSaveDir(t *testing.T) string {
+	t.Helper()
+	previous := SaveDir
+	SaveDir = t.TempDir()
+	t.Cleanup(func() { SaveDir = previous })
+	return SaveDir
+}
+
+func TestSaveFiles(t *testing.T) {
+	dir := setTestSaveDir(t)
+	for _, fichier := range []string{JournalFile(), StatsFile(), PickerFile("words.txt")} {
+		if filepath.Dir(fichier) != dir {
+			t.Errorf("%s isn't in SaveDir %s", fichier, dir)
+		}
+	}
+}
+
+func TestSlotPath(t *testing.T) {
+	slots := NewSlotManager("saves")
+	tests := []struct {
+		name string
+		path string // Empty if the name is refused
+	}{
+		{"alice", filepath.Join("saves", "alice.txt")},
+		{"alice.txt", filepath.Join("saves", "alice.txt")},
+		{"Bob 2", filepath.Join("saves", "Bob 2.txt")},
+		{"", ""},
+		{".txt", ""},
+		{".autosave", ""},
+		{"../key", ""},
+		{"a/b", ""},
+		{`a\b`, ""},
+		{"c:save", ""},
+	}
+	for _, test := range tests {
+		path, err := slots.path(test.name)
+		if test.path == "" {
+			if !errors.Is(err, ErrInvalidSlot) {
+				t.Errorf("path(%q) = %q, %v, expected ErrInvalidSlot", test.name, path, err)
+			}
+			continue
+		}
+		if err != nil || path != test.path {
+			t.Errorf("path(%q) = %q, %v, expected %q", test.name, path, err, test.path)
+		}
+	}
+}
+
+func TestSlotManager(t *testing.T) {
+	setTestKey(t)
+	slots := NewSlotManager(filepath.Join(t.TempDir(), "saves"))
+	if list, err := slots.List(); err != nil || len(list) != 0 {
+		t.Errorf("List of a missing directory = %v, %v, expected no slot", list, err)
+	}
+
+	for _, name := range []string{"alice", "bob"} {
+		hang := newTestGame("hello", NormalRules)
+		hang.Dictionary, hang.Mode = "words.txt", "classic"
+		if err := slots.Save(name, *hang); err != nil {
+			t.Fatalf("Save(%q) = %v", name, err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(slots.Dir, "edited.txt"), []byte(`{"version":2,"game":{}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, other := range []string{".autosave.txt", "notes.md"} { // Not slots
+		if err := os.WriteFile(filepath.Join(slots.Dir, other), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	list, err := slots.List()
+	if err != nil {
+		t.Fatalf("List = %v", err)
+	}
+	infos := map[string]SlotInfo{}
+	for i, info := range list {
+		infos[info.Name] = info
+		if i > 0 && info.Date.After(list[i-1].Date) {
+			t.Errorf("List isn't sorted by date: %v", list)
+		}
+	}
+	if len(infos) != 3 {
+		t.Fatalf("List = %v, expected alice, bob and edited", list)
+	}
+	if info := infos["alice"]; info.Err != nil || info.WordLength != 5 || info.Attempts != 10 || info.Dictionary != "words.txt" || info.Mode != "classic" {
+		t.Errorf("List gave %+v for alice", info)
+	}
+	if info := infos["edited"]; !errors.Is(info.Err, ErrSaveTampered) {
+		t.Errorf("List gave %+v for an edited slot, expected ErrSaveTampered", info)
+	}
+
+	if err := slots.Delete("alice"); err != nil {
+		t.Errorf("Delete = %v", err)
+	}
+	if _, err := slots.Load("alice"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Load of a deleted slot = %v, expected os.ErrNotExist", err)
+	}
+	if err := slots.Delete("alice"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Delete of a deleted slot = %v, expected os.ErrNotExist", err)
+	}
+	if err := slots.Delete("../bob"); !errors.Is(err, ErrInvalidSlot) {
+		t.Errorf("Delete out of the directory = %v, expected ErrInvalidSlot", err)
+	}
+}
+
+func TestResumedSlot(t *testing.T) {
+	setTestKey(t)
+	discardOutput(t) // STOP tells where the game is saved
+	slots := NewSlotManager(setTestSaveDir(t))
+	tests := []struct {
+		name   string
+		input  string
+		player string // Slot written by STOP, the loaded one if empty
+		kept   []string
+	}{
+		{name: "won", input: "e\nh\nl\no\n"},
+		{name: "lost", input: "a\nb\nc\nd\nf\ng\ni\nj\nk\nm\n"},
+		{name: "unfinished", input: "e\n", kept: []string{"resumed"}},
+		{name: "stopped", input: "e\nSTOP\n", kept: []string{"resumed"}},
+		{name: "stopped in another slot", input: "e\nSTOP\n", player: "other", kept: []string{"other"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, name := range []string{"resumed", "other"} {
+				slots.Delete(name)
+			}
+			if err := slots.Save("resumed", *newTestGame("hello", NormalRules)); err != nil {
+				t.Fatal(err)
+			}
+			hang, err := slots.Load("resumed")
+			if err != nil {
+				t.Fatal(err)
+			}
+			hang.Slot, hang.LoadedSlot = "resumed", "resumed"
+			if test.player != "" {
+				hang.Slot = test.player
+			}
+			var output bytes.Buffer
+			hang.BatchGame(strings.NewReader(test.input), &output)
+
+			list, err := slots.List()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var names []string
+			for _, info := range list {
+				names = append(names, info.Name)
+			}
+			if strings.Join(names, " ") != strings.Join(test.kept, " ") {
+				t.Errorf("slots after the game = %v, expected %v", names, test.kept)
+			}
+		})
+	}
+}
+
+func TestPickSlot(t *testing.T) {
+	setTestKey(t)
+	setTestLocale(t, DefaultLocale)
+	setTestResources(t, Overlay(fstest.MapFS{"Dictionary/test.txt": {Data: []byte("hello\n")}}, Resources()))
+	discardOutput(t)
+	slots := NewSlotManager(setTestSaveDir(t))
+
+	tests := []struct {
+		name   string
+		resume bool
+		kept   bool // True if the slot is still there after the game
+	}{
+		{name: "play", kept: true}, // "1" is a refused guess of the new game
+		{name: "resume", resume: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hang := newTestGame("hello", testRules)
+			for _, letter := range []string{"h", "e", "l"} {
+				hang.Guess(letter)
+			}
+			if err := slots.Save("alice", *hang); err != nil {
+				t.Fatal(err)
+			}
+			setTestInput(t, "1\no\nh\ne\nl\n")
+
+			game := Game{accessible: true, dico: "test.txt"}
+			if test.resume {
+				game.Resume("")
+			}
+			if err := ExploitingArgument(game); err != nil {
+				t.Fatalf("ExploitingArgument = %v", err)
+			}
+			if _, err := slots.Load("alice"); (err == nil) != test.kept {
+				t.Errorf("slot kept = %v, expected %v", err == nil, test.kept)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package hangman
+
+import (
+	"sort"
+	"unicode"
+)
+
+// Solve returns the words of the dictionary that can be the word of a game, and the letter to propose next.
+// The pattern is the word as it is shown, '_' for the letters to find ("h_ll_"), excluded are the letters
+// already proposed that are not in the word. The letter is 0 if there is no word or nothing left to find.
+func Solve(dico []string, pattern string, excluded []rune, ignoreAccents bool) ([]string, rune) {
+	game := HangManData{IgnoreAccents: ignoreAccents, ToFind: pattern}
+	known := map[rune]bool{} // A letter shown in the pattern is shown at all its positions
+	for _, oneRune := range pattern {
+		if oneRune != '_' && IsGuessable(oneRune) {
+			known[game.foldRune(oneRune)] = true
+		}
+	}
+	for _, oneRune := range excluded {
+		known[game.foldRune(oneRune)] = true
+	}
+
+	var words []string
+	seen := map[string]bool{}
+	for _, word := range dico {
+		if !seen[word] && game.matches(word, known) {
+			seen[word] = true
+			words = append(words, word)
+		}
+	}
+	return words, game.bestLetter(words, known)
+}
+
+// Return true if the word can be the pattern of ToFind. The letters of known can't be at the '_' positions:
+// they are either shown or not in the word.
+func (game *HangManData) matches(word string, known map[rune]bool) bool {
+	pattern, letters := []rune(game.ToFind), []rune(word)
+	if len(pattern) != len(letters) {
+		return false
+	}
+	for i, oneRune := range pattern {
+		switch {
+		case oneRune == '_':
+			if !IsGuessable(letters[i]) || known[game.foldRune(letters[i])] {
+				return false
+			}
+		case IsGuessable(oneRune):
+			if !game.SameLetter(oneRune, letters[i]) {
+				return false
+			}
+		case oneRune != letters[i]: // Punctuation is shown as it is
+			return false
+		}
+	}
+	return true
+}
+
+// Return the letter found in the most words at the '_' positions of ToFind, in upper case.
+// The first one in alphabetical order is returned if several letters are in as many words.
+func (game *HangManData) bestLetter(words []string, known map[rune]bool) rune {
+	counts := map[rune]int{}
+	pattern := []rune(game.ToFind)
+	for _, word := range words {
+		inWord := map[rune]bool{}
+		for i, oneRune := range []rune(word) {
+			if pattern[i] == '_' {
+				inWord[game.foldRune(oneRune)] = true
+			}
+		}
+		for letter := range inWord {
+			counts[letter]++
+		}
+	}
+
+	letters := make([]rune, 0, len(counts))
+	for letter := range counts {
+		if !known[letter] {
+			letters = append(letters, letter)
+		}
+	}
+	if len(letters) == 0 {
+		return 0
+	}
+	sort.Slice(letters, func(i, j int) bool {
+		if counts[letters[i]] != counts[letters[j]] {
+			return counts[letters[i]] > counts[letters[j]]
+		}
+		return letters[i] < letters[j]
+	})
+	return unicode.ToUpper(letters[0])
+}
@@ -0,0 +1,35 @@
+package hangman
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSolve(t *testing.T) {
+	dico := []string{"hello", "hullo", "hallo", "hello", "help", "jello", "cello", "Héllo", "l'eau", "lieu"}
+	tests := []struct {
+		name          string
+		pattern       string
+		excluded      string
+		ignoreAccents bool
+		words         []string
+		letter        rune
+	}{
+		{name: "pattern", pattern: "h_ll_", words: []string{"hello", "hullo", "hallo", "Héllo"}, letter: 'O'},
+		{name: "excluded", pattern: "h_ll_", excluded: "uaé", words: []string{"hello"}, letter: 'E'},
+		{name: "shown letter", pattern: "_ello", excluded: "h", words: []string{"jello", "cello"}, letter: 'C'},
+		{name: "accents", pattern: "h_llo", excluded: "aue", words: []string{"Héllo"}, letter: 'É'},
+		{name: "without accents", pattern: "h_llo", excluded: "aue", ignoreAccents: true, letter: 0},
+		{name: "punctuation", pattern: "l'___", words: []string{"l'eau"}, letter: 'A'},
+		{name: "found", pattern: "help", words: []string{"help"}, letter: 0},
+		{name: "no word", pattern: "z___", letter: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			words, letter := Solve(dico, test.pattern, []rune(test.excluded), test.ignoreAccents)
+			if !slices.Equal(words, test.words) || letter != test.letter {
+				t.Errorf("Solve(%q) = %q, %q, expected %q, %q", test.pattern, words, letter, test.words, test.letter)
+			}
+		})
+	}
+}
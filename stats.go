@@ -0,0 +1,132 @@
+package hangman
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Return the history of the finished games, one JSON line per game
+func StatsFile() string {
+	return filepath.Join(SaveDir, ".stats.jsonl")
+}
+
+// A finished game, as written in the history
+type GameRecord struct {
+	Date       time.Time `json:"date"`       // End of the game
+	Word       string    `json:"word"`       // Word to find
+	Dictionary string    `json:"dictionary"` // Dictionary the word comes from
+	Mode       string    `json:"mode"`       // Game mode (termbox, classic, ascii or accessible)
+	Won        bool      `json:"won"`        // True if the word was found
+	Attempts   int       `json:"attempts"`   // Attempts left at the end
+}
+
+// Statistics of the history of the games
+type Stats struct {
+	Played        int       // Number of finished games
+	Won           int       // Games won
+	Lost          int       // Games lost
+	Streak        int       // Games won in a row until the last one
+	BestStreak    int       // Longest series of games won in a row
+	AverageLeft   float64   // Average attempts left in the games won
+	LastPlayed    time.Time // End of the last game
+	attemptsOfWon int
+}
+
+// Adds the finished game to its history, nothing is written if the game has no history
+func (hang *HangManData) recordGame() error {
+	if hang.History == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(hang.History), 0o755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(hang.History, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	record := GameRecord{
+		Date:       time.Now().UTC(),
+		Word:       hang.ToFind,
+		Dictionary: hang.Dictionary,
+		Mode:       hang.Mode,
+		Won:        hang.Status() == Won,
+		Attempts:   hang.Attempts,
+	}
+	if err := json.NewEncoder(file).Encode(record); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// This function reads the history of the games and returns its statistics, an history that doesn't exist has no game.
+// The lines that can't be read are reported as ResourceErrors after the statistics of the others.
+func ReadStats(fichier string) (Stats, error) {
+	var stats Stats
+	readFile, err := os.Open(fichier)
+	if errors.Is(err, os.ErrNotExist) {
+		return stats, nil
+	}
+	if err != nil {
+		return stats, err
+	}
+	defer readFile.Close()
+
+	var errs []error
+	fileScanner := bufio.NewScanner(readFile)
+	for line := 1; fileScanner.Scan(); line++ {
+		var record GameRecord
+		if err := json.Unmarshal(fileScanner.Bytes(), &record); err != nil {
+			errs = append(errs, &ResourceError{File: fichier, Line: line, Err: err})
+			continue
+		}
+		stats.add(record)
+	}
+	if err := fileScanner.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	return stats, errors.Join(errs...)
+}
+
+// Adds a game to the statistics
+func (stats *Stats) add(record GameRecord) {
+	stats.Played++
+	if record.Won {
+		stats.Won++
+		stats.Streak++
+		stats.BestStreak = max(stats.BestStreak, stats.Streak)
+		stats.attemptsOfWon += record.Attempts
+		stats.AverageLeft = float64(stats.attemptsOfWon) / float64(stats.Won)
+	} else {
+		stats.Lost++
+		stats.Streak = 0
+	}
+	if record.Date.After(stats.LastPlayed) {
+		stats.LastPlayed = record.Date
+	}
+}
+
+// Return the part of the games won, in percent
+func (stats Stats) WinRate() float64 {
+	if stats.Played == 0 {
+		return 0
+	}
+	return float64(stats.Won) * 100 / float64(stats.Played)
+}
+
+// Return the statistics as lines of text in the language of the messages
+func (stats Stats) String() string {
+	if stats.Played == 0 {
+		return T("stats.none")
+	}
+	return fmt.Sprintf("%s\n%s\n%s\n%s",
+		T("stats.played", stats.Played, stats.Won, stats.Lost, stats.WinRate()),
+		T("stats.streak", stats.Streak, stats.BestStreak),
+		T("stats.average", stats.AverageLeft),
+		T("stats.last", stats.LastPlayed.Local().Format("2006-01-02 15:04")))
+}
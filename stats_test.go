@@ -0,0 +1,53 @@
+package hangman
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadStats(t *testing.T) {
+	fichier := filepath.Join(t.TempDir(), "saves", ".stats.jsonl")
+	if stats, err := ReadStats(fichier); err != nil || stats.Played != 0 {
+		t.Errorf("ReadStats without history = %+v, %v, expected no game", stats, err)
+	}
+
+	for _, inputs := range [][]string{{"hello"}, {"a", "b", "c", "d", "f", "g"}, {"hello"}, {"hello"}} { // Won, lost, won, won
+		hang := newTestGame("hello", testRules)
+		hang.History = fichier
+		for _, input := range inputs {
+			hang.Guess(input)
+		}
+		if err := hang.recordGame(); err != nil {
+			t.Fatalf("recordGame = %v", err)
+		}
+	}
+	hang := newTestGame("hello", testRules) // Without history
+	if err := hang.recordGame(); err != nil {
+		t.Fatalf("recordGame without history = %v", err)
+	}
+
+	stats, err := ReadStats(fichier)
+	if err != nil {
+		t.Fatalf("ReadStats = %v", err)
+	}
+	if stats.Played != 4 || stats.Won != 3 || stats.Lost != 1 || stats.Streak != 2 || stats.BestStreak != 2 || stats.AverageLeft != 6 || stats.WinRate() != 75 || stats.LastPlayed.IsZero() {
+		t.Errorf("ReadStats = %+v", stats)
+	}
+
+	file, err := os.OpenFile(fichier, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.WriteString("not json\n" + `{"won":true,"attempts":2}` + "\n")
+	file.Close()
+	stats, err = ReadStats(fichier)
+	var resourceErr *ResourceError
+	if !errors.As(err, &resourceErr) || resourceErr.Line != 5 {
+		t.Errorf("ReadStats of an edited history = %v, expected a ResourceError at the line 5", err)
+	}
+	if stats.Played != 5 || stats.Streak != 3 || stats.AverageLeft != 5 {
+		t.Errorf("ReadStats of an edited history = %+v, expected the other lines read", stats)
+	}
+}
@@ -0,0 +1,201 @@
+package hangman
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/nsf/termbox-go"
+)
+
+// Colors of the termbox mode, each one is a termbox color that can be combined with attributes (bold...)
+type Theme struct {
+	Output256 bool              // Use the 256 colors of the terminal, the numbers of the colors are then 0 to 255
+	Main      termbox.Attribute // Border of the main box
+	Hangman   termbox.Attribute // Border of the hangman box
+	Drawing   termbox.Attribute // Hangman drawing
+	Word      termbox.Attribute // Border of the word box
+	Attempts  termbox.Attribute // Border of the attempts box
+	Letter    termbox.Attribute // Border of the input box
+	Used      termbox.Attribute // Border of the used letters box
+	Text      termbox.Attribute // Word, input and used letters
+	Counter   termbox.Attribute // Attempts left in ascii art
+	LastInput termbox.Attribute // Last input in ascii art
+	Win       termbox.Attribute // WIN in ascii art
+	Lose      termbox.Attribute // LOSE in ascii art
+}
+
+// Presets of the themes, DarkTheme is the original one
+var (
+	DarkTheme = Theme{
+		Main: termbox.ColorWhite, Hangman: termbox.ColorLightYellow, Drawing: termbox.ColorBlue,
+		Word: termbox.ColorBlue, Attempts: termbox.ColorBlue, Letter: termbox.ColorGreen, Used: termbox.ColorLightMagenta,
+		Text: termbox.ColorDefault, Counter: termbox.ColorLightGray, LastInput: termbox.ColorLightRed,
+		Win: termbox.ColorGreen, Lose: termbox.ColorRed,
+	}
+	LightTheme = Theme{
+		Main: termbox.ColorBlack, Hangman: termbox.ColorMagenta, Drawing: termbox.ColorBlue,
+		Word: termbox.ColorBlue, Attempts: termbox.ColorBlue, Letter: termbox.ColorGreen, Used: termbox.ColorMagenta,
+		Text: termbox.ColorDefault, Counter: termbox.ColorDarkGray, LastInput: termbox.ColorRed,
+		Win: termbox.ColorGreen, Lose: termbox.ColorRed,
+	}
+	HighContrastTheme = Theme{
+		Main: termbox.ColorLightGray | termbox.AttrBold, Hangman: termbox.ColorLightGray | termbox.AttrBold, Drawing: termbox.ColorLightYellow | termbox.AttrBold,
+		Word: termbox.ColorLightGray | termbox.AttrBold, Attempts: termbox.ColorLightGray | termbox.AttrBold, Letter: termbox.ColorLightGray | termbox.AttrBold, Used: termbox.ColorLightGray | termbox.AttrBold,
+		Text: termbox.ColorLightGray | termbox.AttrBold, Counter: termbox.ColorLightYellow | termbox.AttrBold, LastInput: termbox.ColorLightYellow | termbox.AttrBold,
+		Win: termbox.ColorLightCyan | termbox.AttrBold, Lose: termbox.ColorLightYellow | termbox.AttrBold | termbox.AttrUnderline,
+	}
+	// Okabe-Ito palette: blue and orange instead of green and red
+	ColorblindTheme = Theme{
+		Output256: true,
+		Main:      color256(250), Hangman: color256(227), Drawing: color256(117),
+		Word: color256(32), Attempts: color256(32), Letter: color256(36), Used: color256(175),
+		Text: termbox.ColorDefault, Counter: color256(250), LastInput: color256(208),
+		Win: color256(32) | termbox.AttrBold, Lose: color256(208) | termbox.AttrBold,
+	}
+	// Colorblind theme of the terminals without 256 colors: blue and yellow, the closest to orange of the 16 colors
+	Colorblind16Theme = Theme{
+		Main: termbox.ColorLightGray, Hangman: termbox.ColorLightYellow, Drawing: termbox.ColorLightCyan,
+		Word: termbox.ColorBlue, Attempts: termbox.ColorBlue, Letter: termbox.ColorCyan, Used: termbox.ColorLightMagenta,
+		Text: termbox.ColorDefault, Counter: termbox.ColorLightGray, LastInput: termbox.ColorYellow,
+		Win: termbox.ColorLightBlue | termbox.AttrBold, Lose: termbox.ColorYellow | termbox.AttrBold,
+	}
+)
+
+// Return true if the terminal shows 256 colors, from TERM (xterm-256color...) and COLORTERM (truecolor...)
+func Supports256() bool {
+	if colorterm := os.Getenv("COLORTERM"); colorterm == "truecolor" || colorterm == "24bit" {
+		return true
+	}
+	return strings.Contains(os.Getenv("TERM"), "256")
+}
+
+// Return the termbox color of the number of the 256 colors mode
+func color256(number int) termbox.Attribute {
+	return termbox.Attribute(number + 1)
+}
+
+// Names of the colors in the theme files
+var colorNames = map[string]termbox.Attribute{
+	"default": termbox.ColorDefault, "black": termbox.ColorBlack, "red": termbox.ColorRed, "green": termbox.ColorGreen,
+	"yellow": termbox.ColorYellow, "blue": termbox.ColorBlue, "magenta": termbox.ColorMagenta, "cyan": termbox.ColorCyan,
+	"white": termbox.ColorWhite, "darkgray": termbox.ColorDarkGray, "lightred": termbox.ColorLightRed,
+	"lightgreen": termbox.ColorLightGreen, "lightyellow": termbox.ColorLightYellow, "lightblue": termbox.ColorLightBlue,
+	"lightmagenta": termbox.ColorLightMagenta, "lightcyan": termbox.ColorLightCyan, "lightgray": termbox.ColorLightGray,
+}
+
+// Names of the attributes in the theme files
+var attributeNames = map[string]termbox.Attribute{
+	"bold": termbox.AttrBold, "underline": termbox.AttrUnderline, "reverse": termbox.AttrReverse, "dim": termbox.AttrDim,
+}
+
+// Return the preset corresponding to the given name (dark, light, high-contrast, colorblind or colorblind-16), dark if the name is empty.
+// colorblind is colorblind-16 if the terminal can't show 256 colors (see Supports256).
+// Any other name is the path of a theme file (see LoadTheme).
+func ThemeFor(name string) (Theme, error) {
+	switch strings.ToLower(name) {
+	case "dark", "":
+		return DarkTheme, nil
+	case "light":
+		return LightTheme, nil
+	case "high-contrast":
+		return HighContrastTheme, nil
+	case "colorblind":
+		if !Supports256() {
+			return Colorblind16Theme, nil
+		}
+		return ColorblindTheme, nil
+	case "colorblind-16":
+		return Colorblind16Theme, nil
+	}
+	if _, err := os.Stat(name); err != nil {
+		return Theme{}, ErrUnknownTheme
+	}
+	return LoadTheme(name)
+}
+
+// This function reads a theme file of "key = value" lines (see readKeyValues).
+// "base" is the preset the file starts from (dark by default), "output256" is true or false,
+// the other keys are the fields of Theme in lower case, for example "lose = red bold".
+// A color is a name (red, lightblue...) or a number of the 256 colors, followed by attributes (bold, underline, reverse, dim).
+func LoadTheme(fichier string) (Theme, error) {
+	readFile, err := os.Open(fichier)
+	if err != nil {
+		return Theme{}, err
+	}
+	defer readFile.Close()
+
+	entries, err := readKeyValues(readFile, fichier)
+	if err != nil {
+		return Theme{}, err
+	}
+	theme := DarkTheme
+	for _, entry := range entries {
+		key, value, line := strings.ToLower(entry.Key), strings.ToLower(entry.Value), entry.Line
+
+		switch key {
+		case "base":
+			if !isPreset(value) { // A file can't be the base of another
+				return Theme{}, &ResourceError{File: fichier, Line: line, Err: localErrorf("resource.unknownTheme", value)}
+			}
+			theme, _ = ThemeFor(value)
+			continue
+		case "output256":
+			if theme.Output256, err = strconv.ParseBool(value); err != nil {
+				return Theme{}, &ResourceError{File: fichier, Line: line, Err: localErrorf("resource.invalidOutput256", value)}
+			}
+			continue
+		}
+		field, ok := theme.fields()[key]
+		if !ok {
+			return Theme{}, &ResourceError{File: fichier, Line: line, Err: localErrorf("resource.unknownKey", key)}
+		}
+		if *field, err = parseColor(value); err != nil {
+			return Theme{}, &ResourceError{File: fichier, Line: line, Err: err}
+		}
+	}
+	return theme, nil
+}
+
+// Return true if the name is one of the presets
+func isPreset(name string) bool {
+	switch name {
+	case "dark", "light", "high-contrast", "colorblind", "colorblind-16":
+		return true
+	}
+	return false
+}
+
+// Return the colors of the theme by key of the theme files
+func (theme *Theme) fields() map[string]*termbox.Attribute {
+	return map[string]*termbox.Attribute{
+		"main": &theme.Main, "hangman": &theme.Hangman, "drawing": &theme.Drawing,
+		"word": &theme.Word, "attempts": &theme.Attempts, "letter": &theme.Letter, "used": &theme.Used,
+		"text": &theme.Text, "counter": &theme.Counter, "lastinput": &theme.LastInput,
+		"win": &theme.Win, "lose": &theme.Lose,
+	}
+}
+
+// Return the termbox color of a value of a theme file, for example "lightblue bold" or "208"
+func parseColor(value string) (termbox.Attribute, error) {
+	words := strings.FieldsFunc(value, func(r rune) bool { return r == ' ' || r == '+' || r == '|' })
+	if len(words) == 0 {
+		return 0, localErrorf("resource.noColor")
+	}
+	color, ok := colorNames[words[0]]
+	if !ok {
+		number, err := strconv.Atoi(words[0])
+		if err != nil || number < 0 || number > 255 {
+			return 0, localErrorf("resource.unknownColor", words[0])
+		}
+		color = color256(number)
+	}
+	for _, word := range words[1:] {
+		attribute, ok := attributeNames[word]
+		if !ok {
+			return 0, localErrorf("resource.unknownAttribute", word)
+		}
+		color |= attribute
+	}
+	return color, nil
+}
@@ -0,0 +1,72 @@
+package hangman
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nsf/termbox-go"
+)
+
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		value string
+		color termbox.Attribute
+		ok    bool
+	}{
+		{"red", termbox.ColorRed, true},
+		{"lightblue bold", termbox.ColorLightBlue | termbox.AttrBold, true},
+		{"green+underline|reverse", termbox.ColorGreen | termbox.AttrUnderline | termbox.AttrReverse, true},
+		{"0", color256(0), true},
+		{"208 dim", color256(208) | termbox.AttrDim, true},
+		{"256", 0, false},
+		{"-1", 0, false},
+		{"purple", 0, false},
+		{"red blinking", 0, false},
+		{"", 0, false},
+	}
+	for _, test := range tests {
+		color, err := parseColor(test.value)
+		if (err == nil) != test.ok || color != test.color {
+			t.Errorf("parseColor(%q) = %v, %v, expected %v", test.value, color, err, test.color)
+		}
+	}
+}
+
+func TestLoadTheme(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		line    int // Line of the ResourceError, -1 for no error
+	}{
+		{"theme", "# My theme\nbase = light\n\nLose = Red Bold\n  win = 32\noutput256 = true\n", -1},
+		{"not key value", "base = light\nred\n", 2},
+		{"unknown base", "base = mine.txt\n", 1},
+		{"unknown key", "base = light\ncolor = red\n", 2},
+		{"unknown color", "lose = purple\n", 1},
+		{"invalid output256", "output256 = maybe\n", 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fichier := filepath.Join(t.TempDir(), "theme.txt")
+			if err := os.WriteFile(fichier, []byte(test.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			theme, err := ThemeFor(fichier)
+			checkResourceError(t, err, test.line)
+			if err != nil {
+				return
+			}
+			expected := LightTheme
+			expected.Lose, expected.Win, expected.Output256 = termbox.ColorRed|termbox.AttrBold, color256(32), true
+			if theme != expected {
+				t.Errorf("LoadTheme = %+v, expected %+v", theme, expected)
+			}
+		})
+	}
+
+	if _, err := ThemeFor(filepath.Join(t.TempDir(), "missing.txt")); !errors.Is(err, ErrUnknownTheme) {
+		t.Errorf("ThemeFor of a missing file = %v, expected ErrUnknownTheme", err)
+	}
+}